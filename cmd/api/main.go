@@ -8,62 +8,98 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/marcusziade/jewornotjew/pkg/api"
 	"github.com/marcusziade/jewornotjew/pkg/db"
 	"github.com/marcusziade/jewornotjew/pkg/models"
+	"github.com/schollz/progressbar/v3"
 )
 
 func main() {
 	// Define command line flags
-	dbPath := flag.String("db", "./jewornotjew.db", "Path to SQLite database")
+	dbPath := flag.String("db", "./jewornotjew.db", "Path to SQLite database, or a postgres:// DSN for the Postgres backend")
 	addr := flag.String("addr", ":8081", "HTTP server address")
+	authToken := flag.String("auth-token", "", "Bearer token required on POST/PUT/DELETE /api/profiles (empty leaves the write path open)")
+	corsOrigins := flag.String("cors-origins", "", "Comma-separated list of allowed CORS origins, or * for any (empty disables CORS headers)")
+	rateLimit := flag.Float64("rate-limit", 0, "Requests per second allowed per client IP (0 disables rate limiting)")
+	rateBurst := flag.Int("rate-limit-burst", 20, "Burst size for -rate-limit")
+	cache := flag.Bool("cache", false, "Cache reads in Redis, falling back to direct DB access if Redis is unreachable")
+	redisAddr := flag.String("redis-addr", "localhost:6379", "Redis address for -cache")
+	cacheTTL := flag.Duration("cache-ttl", 5*time.Minute, "TTL for cached reads, for -cache")
+	baseURL := flag.String("base-url", "", "Externally reachable base URL (e.g. https://jewornotjew.example), used in ActivityPub actor/outbox/WebFinger ids (empty uses root-relative ids)")
+	apKeyPath := flag.String("ap-key-path", "", "Path to a PEM-encoded RSA private key used to sign ActivityPub responses (empty serves them unsigned)")
 	flag.Parse()
 
-	// Check if database exists
-	if _, err := os.Stat(*dbPath); os.IsNotExist(err) {
-		log.Fatalf("Database file not found: %s\nRun the scraper first: go run cmd/scraper/main.go", *dbPath)
+	// Check if database exists (skipped for Postgres, which doesn't live on disk)
+	if !strings.HasPrefix(*dbPath, "postgres://") && !strings.HasPrefix(*dbPath, "postgresql://") {
+		if _, err := os.Stat(*dbPath); os.IsNotExist(err) {
+			log.Fatalf("Database file not found: %s\nRun the scraper first: go run cmd/scraper/main.go", *dbPath)
+		}
 	}
 
-	// Connect to database
-	db, err := db.New(*dbPath)
+	// Connect to database (New picks SQLiteStore or PostgresStore based on dbPath)
+	store, err := db.New(*dbPath)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
-	defer db.Close()
+	defer store.Close()
 
-	// Make sure the database is initialized
-	if err := db.InitSchema(); err != nil {
-		log.Printf("Warning: Failed to initialize database schema: %v", err)
+	if *cache {
+		cached, err := db.NewCachedStore(store, *redisAddr, *cacheTTL)
+		if err != nil {
+			log.Printf("Warning: -cache requested but Redis is unreachable, serving uncached: %v", err)
+		} else {
+			store = cached
+		}
 	}
 
 	// Load profiles from data directory if database is empty
-	profiles, err := db.ListProfiles()
+	profiles, err := store.ListProfiles()
 	if err != nil || len(profiles) == 0 {
 		log.Println("No profiles found in database. Importing profiles from data directory...")
-		if err := importProfilesFromData(db); err != nil {
+		if err := importProfilesFromData(store); err != nil {
 			log.Printf("Warning: Failed to import profiles: %v", err)
 		}
 	}
 
 	// Create and start API server
-	server := api.NewServer(db)
+	var opts []api.Option
+	if *authToken != "" {
+		opts = append(opts, api.WithAuthToken(*authToken))
+	}
+	if *corsOrigins != "" {
+		opts = append(opts, api.WithCORSOrigins(strings.Split(*corsOrigins, ",")...))
+	}
+	if *rateLimit > 0 {
+		opts = append(opts, api.WithRateLimit(*rateLimit, *rateBurst))
+	}
+	if *baseURL != "" {
+		opts = append(opts, api.WithBaseURL(*baseURL))
+	}
+	if *apKeyPath != "" {
+		opts = append(opts, api.WithActivityPubKey(*apKeyPath))
+	}
+
+	server := api.NewServer(store, opts...)
 	log.Printf("Starting API server on %s", *addr)
-	log.Printf("API endpoints:\n- GET /api/profiles\n- GET /api/profiles/{name}\n- GET /api/search?q={query}")
+	log.Printf("API endpoints:\n- GET /api/profiles\n- GET/POST /api/profiles\n- GET/PUT/DELETE /api/profiles/{name}\n- GET /api/search?q={query}\n- GET /api/stats\n- GET /api/feed.rss, /api/feed.atom, /api/category/{name}/feed.rss, /api/category/{name}/feed.atom\n- GET /ap/actor/{name}, /ap/outbox/{name}, /.well-known/webfinger?resource=acct:{name}@host\n- GET /profiles/{name} (h-card), POST /webmention\n- GET /api/v1/accounts/{id}, /api/v1/accounts/lookup, /api/v1/accounts/search\n- POST /graphql (profile, profiles(category, verdict, scoreGte, nameContains, sortBy, first, after), search), GET /graphql/ui\n- GET /healthz\n- GET /metrics")
 	if err := server.ListenAndServe(*addr); err != nil {
 		log.Fatalf("Server failed: %v", err)
 	}
 }
 
-// importProfilesFromData imports profiles from the data directory into the database
-func importProfilesFromData(db *db.DB) error {
+// importProfilesFromData reads every profile JSON file in the data
+// directory and bulk-inserts them with a single InsertProfiles call,
+// showing a progress bar when stdout is a terminal.
+func importProfilesFromData(store db.Store) error {
 	dataDir := "./data"
 	files, err := os.ReadDir(dataDir)
 	if err != nil {
 		return fmt.Errorf("failed to read data directory: %w", err)
 	}
 
-	importCount := 0
+	var profiles []*models.Profile
 	for _, file := range files {
 		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
 			continue
@@ -81,14 +117,29 @@ func importProfilesFromData(db *db.DB) error {
 			log.Printf("Failed to unmarshal profile from %s: %v", filePath, err)
 			continue
 		}
+		profiles = append(profiles, &profile)
+	}
 
-		if err := db.InsertProfile(&profile); err != nil {
-			log.Printf("Failed to insert profile %s: %v", profile.Name, err)
-			continue
-		}
-		importCount++
+	var onProgress db.ProgressFunc
+	if isTerminal(os.Stdout) {
+		bar := progressbar.Default(int64(len(profiles)), "Importing profiles")
+		onProgress = func(done, total int) { bar.Set(done) }
+	}
+
+	if err := store.InsertProfiles(profiles, onProgress); err != nil {
+		return fmt.Errorf("failed to insert profiles: %w", err)
 	}
 
-	log.Printf("Imported %d profiles into the database", importCount)
+	log.Printf("Imported %d profiles into the database", len(profiles))
 	return nil
+}
+
+// isTerminal reports whether f is connected to a character device (a TTY),
+// so callers can decide whether a progress bar is worth drawing.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
 }
\ No newline at end of file
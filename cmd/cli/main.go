@@ -8,7 +8,10 @@ import (
 	"os"
 	"strings"
 
+	"github.com/marcusziade/jewornotjew/pkg/client"
 	"github.com/marcusziade/jewornotjew/pkg/db"
+	"github.com/marcusziade/jewornotjew/pkg/exporter"
+	"github.com/marcusziade/jewornotjew/pkg/importer"
 	"github.com/marcusziade/jewornotjew/pkg/models"
 )
 
@@ -28,31 +31,19 @@ var (
 func main() {
 	// Print fancy header
 	printHeader()
-	
+
 	// Define command line flags
-	dbPath := flag.String("db", "./jewornotjew.db", "Path to SQLite database")
+	dbPath := flag.String("db", "./jewornotjew.db", "Path to SQLite database, or a postgres:// DSN for the Postgres backend")
+	dataDir := flag.String("data", "./data", "Path to the JSON profile data directory (import/export)")
 	jsonOutput := flag.Bool("json", false, "Output in JSON format")
 	noColor := flag.Bool("no-color", false, "Disable colored output")
 	flag.Parse()
-	
+
 	// Disable colors if requested
 	if *noColor {
 		disableColors()
 	}
 
-	// Check if database exists
-	if _, err := os.Stat(*dbPath); os.IsNotExist(err) {
-		log.Fatalf("%sDatabase file not found:%s %s\n%sRun the scraper first:%s go run cmd/scraper/main.go", 
-			ColorRed+Bold, ColorReset, *dbPath, ColorYellow+Bold, ColorReset)
-	}
-
-	// Connect to database
-	db, err := db.New(*dbPath)
-	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
-	}
-	defer db.Close()
-
 	// Get command
 	args := flag.Args()
 	if len(args) < 1 {
@@ -62,6 +53,55 @@ func main() {
 
 	command := args[0]
 
+	switch command {
+	case "list", "get":
+		runDBCommand(*dbPath, *jsonOutput, command, args[1:])
+
+	case "search":
+		runSearch(*dbPath, *jsonOutput, args[1:])
+
+	case "import":
+		runImport(*dataDir, args[1:])
+
+	case "export":
+		runExport(*dataDir, args[1:])
+
+	case "reclassify":
+		runReclassify(*dataDir, args[1:])
+
+	default:
+		fmt.Printf("Unknown command: %s\n", command)
+		printUsage()
+		os.Exit(1)
+	}
+}
+
+// requireDBExists exits with a helpful message if dbPath names a SQLite
+// file that doesn't exist yet. Postgres DSNs don't live on disk, so they
+// skip the check and surface connection failures through db.New instead.
+func requireDBExists(dbPath string) {
+	if strings.HasPrefix(dbPath, "postgres://") || strings.HasPrefix(dbPath, "postgresql://") {
+		return
+	}
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		log.Fatalf("%sDatabase file not found:%s %s\n%sRun the scraper first:%s go run cmd/scraper/main.go",
+			ColorRed+Bold, ColorReset, dbPath, ColorYellow+Bold, ColorReset)
+	}
+}
+
+// runDBCommand handles the list/get commands, which read an
+// already-scraped database (SQLite by default, or Postgres via a
+// postgres:// DSN).
+func runDBCommand(dbPath string, jsonOutput bool, command string, args []string) {
+	requireDBExists(dbPath)
+
+	// Connect to database
+	db, err := db.New(dbPath)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
 	switch command {
 	case "list":
 		// List all profiles
@@ -69,41 +109,175 @@ func main() {
 		if err != nil {
 			log.Fatalf("Failed to list profiles: %v", err)
 		}
-		outputProfiles(profiles, *jsonOutput)
-
-	case "search":
-		// Search for profiles
-		if len(args) < 2 {
-			fmt.Println("Error: search command requires a query")
-			printUsage()
-			os.Exit(1)
-		}
-		query := args[1]
-		profiles, err := db.SearchProfiles(query)
-		if err != nil {
-			log.Fatalf("Failed to search profiles: %v", err)
-		}
-		outputProfiles(profiles, *jsonOutput)
+		outputProfiles(profiles, jsonOutput)
 
 	case "get":
 		// Get a specific profile
-		if len(args) < 2 {
+		if len(args) < 1 {
 			fmt.Println("Error: get command requires a name")
 			printUsage()
 			os.Exit(1)
 		}
-		name := args[1]
+		name := args[0]
 		profile, err := db.GetProfile(name)
 		if err != nil {
 			log.Fatalf("Failed to get profile: %v", err)
 		}
-		outputProfile(profile, *jsonOutput)
+		outputProfile(profile, jsonOutput)
+	}
+}
 
-	default:
-		fmt.Printf("Unknown command: %s\n", command)
+// runSearch handles the search command. Plain search falls back to the
+// existing LIKE-based db.SearchProfiles; -fts switches to the ranked
+// fts_profiles index with pagination and highlighted snippets.
+func runSearch(dbPath string, jsonOutput bool, args []string) {
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	fts := fs.Bool("fts", false, "Use the ranked FTS5 index (MATCH syntax: quoted phrases, OR, prefix*, column:term)")
+	limit := fs.Int("limit", 20, "Max results to return (fts mode only)")
+	offset := fs.Int("offset", 0, "Result offset for pagination (fts mode only)")
+	fs.Parse(args)
+
+	queryArgs := fs.Args()
+	if len(queryArgs) < 1 {
+		fmt.Println("Error: search command requires a query")
 		printUsage()
 		os.Exit(1)
 	}
+	query := queryArgs[0]
+
+	requireDBExists(dbPath)
+
+	d, err := db.New(dbPath)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer d.Close()
+
+	if !*fts {
+		profiles, err := d.SearchProfiles(query)
+		if err != nil {
+			log.Fatalf("Failed to search profiles: %v", err)
+		}
+		outputProfiles(profiles, jsonOutput)
+		return
+	}
+
+	results, err := d.SearchProfilesFTS(query, *limit, *offset)
+	if err != nil {
+		log.Fatalf("Failed to search profiles: %v", err)
+	}
+	outputFTSResults(results, jsonOutput)
+}
+
+// runImport ingests profiles from a third-party dataset (see pkg/importer)
+// and saves each one into dataDir via pkg/client, the same place the
+// scraper writes to.
+func runImport(dataDir string, args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	flavor := fs.String("flavor", "", "Import flavor: wikidata, csv, or activitystreams")
+	source := fs.String("source", "", "Path to the source file")
+	fs.Parse(args)
+
+	if *flavor == "" || *source == "" {
+		log.Fatalf("import requires --flavor and --source")
+	}
+
+	imp, err := importer.New(*flavor)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	profiles, err := imp.Import(*source)
+	if err != nil {
+		log.Fatalf("Failed to import %s: %v", *source, err)
+	}
+
+	c, err := client.NewClient(client.WithDataDir(dataDir))
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+
+	for _, profile := range profiles {
+		c.AddProfile(profile)
+		if err := c.SaveProfileToJSON(profile); err != nil {
+			log.Fatalf("Failed to save profile %s: %v", profile.Name, err)
+		}
+	}
+
+	fmt.Printf("%sImported %d profiles from %s (flavor: %s)%s\n", ColorGreen, len(profiles), *source, *flavor, ColorReset)
+}
+
+// runExport loads the JSON profile corpus from dataDir and writes it out in
+// one of pkg/exporter's formats.
+func runExport(dataDir string, args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "json", "Export format: csv, ndjson, or json")
+	output := fs.String("output", "", "Output file path")
+	fs.Parse(args)
+
+	if *output == "" {
+		log.Fatalf("export requires --output")
+	}
+
+	c, err := client.NewClient(client.WithDataDir(dataDir))
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+	if err := c.LoadFromDisk(); err != nil {
+		log.Fatalf("Failed to load profiles from %s: %v", dataDir, err)
+	}
+
+	profiles := c.ListProfiles()
+	if err := exporter.Export(profiles, *format, *output); err != nil {
+		log.Fatalf("Failed to export profiles: %v", err)
+	}
+
+	fmt.Printf("%sExported %d profiles to %s (format: %s)%s\n", ColorGreen, len(profiles), *output, *format, ColorReset)
+}
+
+// runReclassify bulk re-labels low-confidence profiles using pkg/classify
+// (via client.Reclassify), so the category dataset can be curated
+// iteratively instead of trusting whatever the scraper originally guessed.
+func runReclassify(dataDir string, args []string) {
+	fs := flag.NewFlagSet("reclassify", flag.ExitOnError)
+	threshold := fs.Float64("threshold", 0.6, "Only relabel profiles whose existing CategoryConfidence is below this")
+	dryRun := fs.Bool("dry-run", false, "Print proposed changes without saving them")
+	fs.Parse(args)
+
+	c, err := client.NewClient(client.WithDataDir(dataDir))
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+	if err := c.LoadFromDisk(); err != nil {
+		log.Fatalf("Failed to load profiles from %s: %v", dataDir, err)
+	}
+
+	relabeled := 0
+	for _, profile := range c.ListProfiles() {
+		if profile.Category != "" && profile.CategoryConfidence >= *threshold {
+			continue
+		}
+
+		category, confidence := c.Reclassify(profile)
+		if category == "" || category == profile.Category {
+			continue
+		}
+
+		fmt.Printf("%s%s%s: %q -> %q (confidence %.2f)\n", ColorCyan, profile.Name, ColorReset, profile.Category, category, confidence)
+		relabeled++
+
+		if *dryRun {
+			continue
+		}
+
+		profile.Category = category
+		profile.CategoryConfidence = confidence
+		if err := c.SaveProfileToJSON(profile); err != nil {
+			log.Printf("Failed to save %s: %v", profile.Name, err)
+		}
+	}
+
+	fmt.Printf("%sRelabeled %d profiles%s\n", ColorGreen, relabeled, ColorReset)
 }
 
 func printUsage() {
@@ -112,18 +286,25 @@ func printUsage() {
 	
 	fmt.Printf("\n%s%sCommands:%s\n", Bold, ColorCyan, ColorReset)
 	fmt.Printf("  %slist%s                  List all profiles\n", Bold, ColorReset)
-	fmt.Printf("  %ssearch%s <query>        Search for profiles\n", Bold, ColorReset)
+	fmt.Printf("  %ssearch%s [--fts] [--limit=<n>] [--offset=<n>] <query>   Search for profiles (--fts for ranked FTS5 matches)\n", Bold, ColorReset)
 	fmt.Printf("  %sget%s <name>            Get a specific profile\n", Bold, ColorReset)
-	
+	fmt.Printf("  %simport%s --flavor=<x> --source=<path>   Import profiles from a third-party dataset\n", Bold, ColorReset)
+	fmt.Printf("  %sexport%s --format=<x> --output=<path>   Export the JSON profile corpus\n", Bold, ColorReset)
+	fmt.Printf("  %sreclassify%s --threshold=<x> [--dry-run]   Bulk re-label low-confidence categories\n", Bold, ColorReset)
+
 	fmt.Printf("\n%s%sFlags:%s\n", Bold, ColorCyan, ColorReset)
-	fmt.Printf("  %s-db%s <path>            Path to SQLite database (default: ./jewornotjew.db)\n", Bold, ColorReset)
+	fmt.Printf("  %s-db%s <path>            Path to SQLite database, or a postgres:// DSN (default: ./jewornotjew.db)\n", Bold, ColorReset)
+	fmt.Printf("  %s-data%s <path>          Path to the JSON profile data directory (default: ./data)\n", Bold, ColorReset)
 	fmt.Printf("  %s-json%s                 Output in JSON format\n", Bold, ColorReset)
 	fmt.Printf("  %s-no-color%s             Disable colored output\n", Bold, ColorReset)
-	
+
 	fmt.Printf("\n%s%sExamples:%s\n", Bold, ColorCyan, ColorReset)
 	fmt.Println("  go run cmd/cli/main.go list")
 	fmt.Println("  go run cmd/cli/main.go search \"Einstein\"")
+	fmt.Println("  go run cmd/cli/main.go search --fts --limit=10 'verdict:jew* OR \"hollywood actor\"'")
 	fmt.Println("  go run cmd/cli/main.go get \"Leonard Nimoy\"")
+	fmt.Println("  go run cmd/cli/main.go import --flavor=csv --source=profiles.csv")
+	fmt.Println("  go run cmd/cli/main.go export --format=ndjson --output=profiles.ndjson")
 }
 
 // printHeader prints a fancy ASCII art header
@@ -193,6 +374,30 @@ func outputProfiles(profiles []*models.Profile, jsonFormat bool) {
 	}
 }
 
+func outputFTSResults(results []*db.FTSSearchResult, jsonFormat bool) {
+	if jsonFormat {
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to marshal search results to JSON: %v", err)
+		}
+		fmt.Println(string(data))
+		return
+	}
+
+	if len(results) == 0 {
+		fmt.Printf("%sNo profiles found%s\n", ColorYellow, ColorReset)
+		return
+	}
+
+	fmt.Printf("%s%sFound %d profiles:%s\n\n", Bold, ColorCyan, len(results), ColorReset)
+
+	for _, r := range results {
+		fmt.Printf("%s%sName:%s %s %s(rank %.2f)%s\n", Bold, ColorBlue, ColorReset, r.Profile.Name, ColorYellow, r.Rank, ColorReset)
+		fmt.Printf("%s%sSnippet:%s %s\n", Bold, ColorBlue, ColorReset, r.Snippet)
+		fmt.Printf("%s---%s\n", ColorYellow, ColorReset)
+	}
+}
+
 func outputProfile(profile *models.Profile, jsonFormat bool) {
 	if jsonFormat {
 		data, err := json.MarshalIndent(profile, "", "  ")
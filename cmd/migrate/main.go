@@ -0,0 +1,55 @@
+// Command migrate brings a pkg/db-backed database's schema up to date (or
+// rolls back the most recent migration) without needing the full scraper,
+// API, or CLI binaries. It shares the same embedded migrations and
+// schema_migrations tracking that db.New applies automatically, so it's
+// mainly useful for operators who want to run migrations as an explicit
+// deploy step ahead of starting cmd/api against Postgres.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/marcusziade/jewornotjew/pkg/db"
+)
+
+func main() {
+	dsn := flag.String("dsn", "./jewornotjew.db", "Path to SQLite database, or a postgres:// DSN for the Postgres backend")
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) < 1 {
+		fmt.Println("Usage: go run cmd/migrate/main.go [-dsn=<path-or-dsn>] <up|down>")
+		os.Exit(1)
+	}
+
+	store, err := db.New(*dsn)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer store.Close()
+
+	switch args[0] {
+	case "up":
+		if err := store.InitSchema(); err != nil {
+			log.Fatalf("Failed to apply migrations: %v", err)
+		}
+		fmt.Println("Database is up to date")
+
+	case "down":
+		version, err := db.RevertLastMigration(*dsn)
+		if err != nil {
+			log.Fatalf("Failed to roll back migration: %v", err)
+		}
+		if version == 0 {
+			fmt.Println("No migrations to roll back")
+			return
+		}
+		fmt.Printf("Rolled back migration %d\n", version)
+
+	default:
+		log.Fatalf("Unknown command: %s (expected up or down)", args[0])
+	}
+}
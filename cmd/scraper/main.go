@@ -11,6 +11,7 @@ import (
 	"github.com/marcusziade/jewornotjew/pkg/client"
 	"github.com/marcusziade/jewornotjew/pkg/db"
 	"github.com/marcusziade/jewornotjew/pkg/models"
+	"github.com/schollz/progressbar/v3"
 )
 
 func main() {
@@ -19,6 +20,9 @@ func main() {
 	dbPath := flag.String("db-path", "./jewornotjew.db", "Path to SQLite database")
 	baseURL := flag.String("base-url", "http://jewornotjew.com", "Base URL to scrape")
 	loadOnly := flag.Bool("load-only", false, "Only load data from disk, don't scrape")
+	cacheConfigPath := flag.String("cache-config", "", "Path to a cache config.json ({enabled, path, lifetime, max_size}); empty disables the HTTP cache")
+	refresh := flag.Bool("refresh", false, "Bypass the HTTP cache's freshness check and re-fetch every profile, refreshing the cache")
+	incremental := flag.Bool("incremental", false, "Load profiles already saved to -data-dir first and only scrape what's missing or changed")
 	flag.Parse()
 
 	// Create data directory if it doesn't exist
@@ -27,10 +31,19 @@ func main() {
 	}
 
 	// Initialize client
-	c, err := client.NewClient(
+	opts := []client.Option{
 		client.WithBaseURL(*baseURL),
 		client.WithDataDir(*dataDir),
-	)
+	}
+	if *cacheConfigPath != "" {
+		cacheCfg, err := client.LoadCacheConfig(*cacheConfigPath)
+		if err != nil {
+			log.Fatalf("Failed to load cache config: %v", err)
+		}
+		opts = append(opts, client.WithCache(cacheCfg), client.WithCacheRefresh(*refresh))
+	}
+
+	c, err := client.NewClient(opts...)
 	if err != nil {
 		log.Fatalf("Failed to create client: %v", err)
 	}
@@ -43,7 +56,7 @@ func main() {
 		}
 	} else {
 		fmt.Println("Scraping profiles...")
-		if err := c.ScrapeAll(); err != nil {
+		if err := c.ScrapeAll(*incremental); err != nil {
 			log.Fatalf("Failed to scrape profiles: %v", err)
 		}
 	}
@@ -140,12 +153,15 @@ func main() {
 		profiles = mockProfiles
 	}
 	
-	// Load profiles into database
+	// Load profiles into database in a single batched transaction
 	fmt.Println("Loading profiles into database...")
-	for _, profile := range profiles {
-		if err := db.InsertProfile(profile); err != nil {
-			log.Printf("Failed to insert profile %s: %v", profile.Name, err)
-		}
+	var onProgress func(done, total int)
+	if isTerminal(os.Stdout) {
+		bar := progressbar.Default(int64(len(profiles)), "Loading profiles")
+		onProgress = func(done, total int) { bar.Set(done) }
+	}
+	if err := db.InsertProfiles(profiles, onProgress); err != nil {
+		log.Fatalf("Failed to insert profiles: %v", err)
 	}
 
 	fmt.Printf("Successfully processed %d profiles\n", len(profiles))
@@ -159,4 +175,14 @@ func main() {
 	fmt.Printf("    go run cmd/cli/main.go -db %s list\n", absPath)
 	fmt.Println("  Search for profiles:")
 	fmt.Printf("    go run cmd/cli/main.go -db %s search \"Einstein\"\n", absPath)
+}
+
+// isTerminal reports whether f is connected to a character device (a TTY),
+// so callers can decide whether a progress bar is worth drawing.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
 }
\ No newline at end of file
@@ -0,0 +1,210 @@
+// Package activitypub renders models.Profile as ActivityStreams 2.0
+// objects - an actor, an outbox of update activities, and the WebFinger
+// record that lets a Mastodon-compatible server discover the actor by
+// acct: handle - so profiles can be followed and referenced from the
+// fediverse the same way a person or bot account can.
+package activitypub
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// contextURLs is the @context every object below ships: the core
+// ActivityStreams vocabulary plus the security vocabulary PropertyValue
+// and the HTTP Signatures publicKey field come from.
+var contextURLs = []string{
+	"https://www.w3.org/ns/activitystreams",
+	"https://w3id.org/security/v1",
+}
+
+// PropertyValue is the security vocabulary's key/value attachment, used by
+// Mastodon profile fields.
+type PropertyValue struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// PublicKey is the actor's HTTP Signatures public key, published so
+// remote servers can verify responses signed on the actor's behalf.
+type PublicKey struct {
+	Id           string `json:"id"`
+	Owner        string `json:"owner"`
+	PublicKeyPem string `json:"publicKeyPem"`
+}
+
+// Actor is a models.Profile rendered as an ActivityStreams actor. Its
+// type is "Profile" rather than "Person": these are scraped verdicts
+// about historical and public figures, not accounts the figures
+// themselves control.
+type Actor struct {
+	Context           []string        `json:"@context"`
+	Id                string          `json:"id"`
+	Type              string          `json:"type"`
+	Name              string          `json:"name"`
+	PreferredUsername string          `json:"preferredUsername"`
+	Summary           string          `json:"summary"`
+	Icon              *Image          `json:"icon,omitempty"`
+	URL               string          `json:"url"`
+	Published         string          `json:"published"`
+	Inbox             string          `json:"inbox"`
+	Outbox            string          `json:"outbox"`
+	Attachment        []PropertyValue `json:"attachment"`
+	PublicKey         *PublicKey      `json:"publicKey,omitempty"`
+}
+
+// Image is an actor's icon.
+type Image struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// BuildActor renders profile as an Actor. baseURL is the API's external
+// base URL (e.g. "https://jewornotjew.example"), used to build every
+// absolute id/link; pubKeyPEM, if non-empty, is published as the actor's
+// HTTP Signatures public key.
+func BuildActor(profile *models.Profile, baseURL, pubKeyPEM string) *Actor {
+	actorID := baseURL + "/ap/actor/" + profile.Name
+
+	actor := &Actor{
+		Context:           contextURLs,
+		Id:                actorID,
+		Type:              "Profile",
+		Name:              profile.Name,
+		PreferredUsername: profile.Name,
+		Summary:           profile.Description,
+		URL:               profile.URL,
+		Published:         profile.CreatedAt,
+		Inbox:             actorID + "/inbox",
+		Outbox:            baseURL + "/ap/outbox/" + profile.Name,
+		Attachment:        profileAttachments(profile),
+	}
+
+	if profile.ImageURL != "" {
+		actor.Icon = &Image{Type: "Image", URL: profile.ImageURL}
+	}
+	if pubKeyPEM != "" {
+		actor.PublicKey = &PublicKey{
+			Id:           actorID + "#main-key",
+			Owner:        actorID,
+			PublicKeyPem: pubKeyPEM,
+		}
+	}
+
+	return actor
+}
+
+// profileAttachments renders Verdict, Category, Score, and every Pro/Con
+// as PropertyValue attachments, the same way Mastodon profile metadata
+// fields are rendered.
+func profileAttachments(profile *models.Profile) []PropertyValue {
+	attachments := []PropertyValue{
+		{Type: "PropertyValue", Name: "Verdict", Value: profile.Verdict},
+		{Type: "PropertyValue", Name: "Category", Value: profile.Category},
+		{Type: "PropertyValue", Name: "Score", Value: strconv.FormatFloat(profile.Score, 'f', -1, 64)},
+	}
+	for i, pro := range profile.Pros {
+		attachments = append(attachments, PropertyValue{
+			Type: "PropertyValue", Name: fmt.Sprintf("Pro %d", i+1), Value: pro,
+		})
+	}
+	for i, con := range profile.Cons {
+		attachments = append(attachments, PropertyValue{
+			Type: "PropertyValue", Name: fmt.Sprintf("Con %d", i+1), Value: con,
+		})
+	}
+	return attachments
+}
+
+// Note is the object of a Create activity in an actor's outbox.
+type Note struct {
+	Type         string `json:"type"`
+	Id           string `json:"id"`
+	AttributedTo string `json:"attributedTo"`
+	Content      string `json:"content"`
+	Published    string `json:"published"`
+}
+
+// CreateActivity wraps a Note the way Mastodon's outbox entries do.
+type CreateActivity struct {
+	Context   []string `json:"@context"`
+	Id        string   `json:"id"`
+	Type      string   `json:"type"`
+	Actor     string   `json:"actor"`
+	Published string   `json:"published"`
+	Object    Note     `json:"object"`
+}
+
+// OrderedCollection is an ActivityStreams OrderedCollection of activities.
+type OrderedCollection struct {
+	Context      []string         `json:"@context"`
+	Id           string           `json:"id"`
+	Type         string           `json:"type"`
+	TotalItems   int              `json:"totalItems"`
+	OrderedItems []CreateActivity `json:"orderedItems"`
+}
+
+// BuildOutbox renders profile's single known update (its CreatedAt/
+// UpdatedAt pair - pkg/db doesn't keep a change history beyond the two
+// timestamps) as an OrderedCollection with one Create{Note} activity.
+func BuildOutbox(profile *models.Profile, baseURL string) *OrderedCollection {
+	actorID := baseURL + "/ap/actor/" + profile.Name
+	outboxID := baseURL + "/ap/outbox/" + profile.Name
+
+	note := Note{
+		Type:         "Note",
+		Id:           outboxID + "/note",
+		AttributedTo: actorID,
+		Content:      fmt.Sprintf("%s was last updated: %s (%s)", profile.Name, profile.Verdict, profile.Description),
+		Published:    profile.UpdatedAt,
+	}
+
+	activity := CreateActivity{
+		Context:   contextURLs,
+		Id:        outboxID + "/activity/1",
+		Type:      "Create",
+		Actor:     actorID,
+		Published: profile.UpdatedAt,
+		Object:    note,
+	}
+
+	return &OrderedCollection{
+		Context:      contextURLs,
+		Id:           outboxID,
+		Type:         "OrderedCollection",
+		TotalItems:   1,
+		OrderedItems: []CreateActivity{activity},
+	}
+}
+
+// WebFingerLink is one entry in a WebFinger resource's links array.
+type WebFingerLink struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type"`
+	Href string `json:"href"`
+}
+
+// WebFingerResource is the JRD document served from
+// /.well-known/webfinger?resource=acct:name@host.
+type WebFingerResource struct {
+	Subject string          `json:"subject"`
+	Links   []WebFingerLink `json:"links"`
+}
+
+// BuildWebFinger returns the WebFinger record resolving acct:name@host to
+// its actor at baseURL/ap/actor/{name}.
+func BuildWebFinger(name, host, baseURL string) *WebFingerResource {
+	return &WebFingerResource{
+		Subject: "acct:" + name + "@" + host,
+		Links: []WebFingerLink{
+			{
+				Rel:  "self",
+				Type: "application/activity+json",
+				Href: baseURL + "/ap/actor/" + name,
+			},
+		},
+	}
+}
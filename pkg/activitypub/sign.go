@@ -0,0 +1,99 @@
+package activitypub
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Signer signs outgoing actor/outbox responses with the draft-cavage HTTP
+// Signatures scheme Mastodon and other ActivityPub servers use to verify
+// a response actually came from the actor it claims to. One Signer's key
+// is shared by every actor this server publishes; SignResponse takes the
+// specific keyId to sign as, since that has to match the PublicKey.Id the
+// actor being dereferenced actually published.
+type Signer struct {
+	privateKey *rsa.PrivateKey
+}
+
+// NewSigner loads a PEM-encoded RSA private key from keyPath.
+func NewSigner(keyPath string) (*Signer, error) {
+	data, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key %s: %w", keyPath, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found in %s", keyPath)
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		keyAny, err2 := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err2 != nil {
+			return nil, fmt.Errorf("failed to parse private key %s: %w", keyPath, err)
+		}
+		rsaKey, ok := keyAny.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("private key %s is not RSA", keyPath)
+		}
+		key = rsaKey
+	}
+
+	return &Signer{privateKey: key}, nil
+}
+
+// PublicKeyPEM returns the signer's public key, PEM-encoded in PKIX form,
+// for publishing via BuildActor.
+func (s *Signer) PublicKeyPEM() (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(&s.privateKey.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal public key: %w", err)
+	}
+	block := &pem.Block{Type: "PUBLIC KEY", Bytes: der}
+	return string(pem.EncodeToMemory(block)), nil
+}
+
+// SignResponse adds Digest, Date, and Signature headers to w, covering
+// body and the request line r was served for, so a Mastodon-compatible
+// server dereferencing this actor/outbox/WebFinger response can verify it
+// came from s's key, per draft-cavage-http-signatures. keyID must match
+// the PublicKey.Id the relevant actor publishes, or verification will
+// look up the wrong key.
+func (s *Signer) SignResponse(w http.ResponseWriter, r *http.Request, body []byte, keyID string) error {
+	digest := sha256.Sum256(body)
+	date := time.Now().UTC().Format(http.TimeFormat)
+
+	w.Header().Set("Digest", "SHA-256="+base64.StdEncoding.EncodeToString(digest[:]))
+	w.Header().Set("Date", date)
+
+	headers := []string{"(request-target)", "host", "date", "digest"}
+	signingString := strings.Join([]string{
+		fmt.Sprintf("(request-target): %s %s", strings.ToLower(r.Method), r.URL.RequestURI()),
+		"host: " + r.Host,
+		"date: " + date,
+		"digest: " + w.Header().Get("Digest"),
+	}, "\n")
+
+	hashed := sha256.Sum256([]byte(signingString))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return fmt.Errorf("failed to sign response: %w", err)
+	}
+
+	w.Header().Set("Signature", fmt.Sprintf(
+		`keyId="%s",algorithm="rsa-sha256",headers="%s",signature="%s"`,
+		keyID, strings.Join(headers, " "), base64.StdEncoding.EncodeToString(sig),
+	))
+	return nil
+}
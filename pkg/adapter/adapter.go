@@ -0,0 +1,221 @@
+// Package adapter defines the SiteAdapter interface that decouples the
+// scraper in pkg/client from any one target site, plus a config-driven
+// implementation so new sites can be added without touching Go code.
+package adapter
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed default.yaml
+var defaultConfigYAML []byte
+
+// SiteAdapter knows how to discover and parse profiles for one scrape target.
+// pkg/client drives ScrapeAll/scrapeProfile purely through this interface,
+// so adding a new site is a matter of shipping a new Config, not editing the
+// scraper.
+type SiteAdapter interface {
+	// HomepageURL returns the page the crawler starts from when discovering IDs.
+	HomepageURL() string
+
+	// ProfileURL returns the URL for a given profile ID.
+	ProfileURL(id int) string
+
+	// DiscoverIDs extracts candidate profile IDs from the homepage document.
+	DiscoverIDs(doc *goquery.Document) []int
+
+	// ParseProfile fills in profile fields by applying the adapter's
+	// extraction rules to doc, returning the (possibly same) profile.
+	ParseProfile(doc *goquery.Document, profile *models.Profile) *models.Profile
+
+	// ExpectedCount is the adapter's best guess at total profile count, shown
+	// in ScrapeAll's completion summary and used by ResumeScrape to bound the
+	// IDs it enqueues (three times this count). 0 means unknown.
+	ExpectedCount() int
+}
+
+// SelectorRule describes how to pull one field out of a document: try each
+// selector in order, read Attr (or the element text when Attr is "text"),
+// and run it through Postprocess. A Config may list several rules for the
+// same Field as a fallback chain — ParseProfile tries them in order and
+// stops at the first one that produces a value.
+type SelectorRule struct {
+	Field       string   `yaml:"field"`
+	Selectors   []string `yaml:"selectors"`
+	Attr        string   `yaml:"attr"`
+	Postprocess []string `yaml:"postprocess"`
+	Fallbacks   []string `yaml:"fallbacks"`
+
+	// Split, when set ("bullets" is the only strategy so far), breaks the
+	// single matched value into multiple list items instead of one scalar
+	// value — for list fields like pros/cons. See splitItems.
+	Split string `yaml:"split"`
+}
+
+// Config is the on-disk (YAML/JSON) description of a scrape target, loaded
+// via LoadConfig and wrapped in a ConfigAdapter.
+type Config struct {
+	Name           string         `yaml:"name"`
+	Homepage       string         `yaml:"homepage"`
+	ProfileURLFmt  string         `yaml:"profile_url_format"`
+	IDDiscoverySel string         `yaml:"id_discovery_selector"`
+	IDRegex        string         `yaml:"id_regex"`
+	ExpectedCount  int            `yaml:"expected_count"`
+	Fields         []SelectorRule `yaml:"fields"`
+}
+
+// LoadConfig reads a YAML (or JSON, which is a YAML subset) adapter config
+// from path.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read adapter config %s: %w", path, err)
+	}
+
+	cfg, err := parseConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse adapter config %s: %w", path, err)
+	}
+	if cfg.ProfileURLFmt == "" {
+		return nil, fmt.Errorf("adapter config %s: profile_url_format is required", path)
+	}
+
+	return cfg, nil
+}
+
+func parseConfig(data []byte) (*Config, error) {
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+var (
+	defaultOnce    sync.Once
+	defaultAdapter *ConfigAdapter
+)
+
+// DefaultConfig returns the field-extraction rules shipped with the binary
+// (pkg/adapter/default.yaml) — the same heuristics jewornotjew.com scraping
+// used to have hardcoded in pkg/client, now tunable without a recompile.
+// It has no profile_url_format, so it is only useful for ParseProfile, not
+// as a full SiteAdapter.
+func DefaultConfig() *Config {
+	defaultOnce.Do(func() {
+		cfg, err := parseConfig(defaultConfigYAML)
+		if err != nil {
+			// defaultConfigYAML is embedded at build time, so a parse
+			// failure here means the shipped file itself is broken.
+			panic(fmt.Sprintf("invalid embedded default adapter config: %v", err))
+		}
+		defaultAdapter = NewConfigAdapter(cfg)
+	})
+	return defaultAdapter.cfg
+}
+
+// Default returns the extraction rules shipped with the binary wrapped as a
+// ConfigAdapter, for callers that only need ParseProfile (e.g. pkg/client's
+// legacy ID-loop scrape path, which has its own HomepageURL/ProfileURL
+// handling and doesn't go through DiscoverIDs).
+func Default() *ConfigAdapter {
+	DefaultConfig()
+	return defaultAdapter
+}
+
+// ConfigAdapter is a SiteAdapter driven entirely by a Config loaded from disk.
+type ConfigAdapter struct {
+	cfg *Config
+}
+
+// NewConfigAdapter wraps cfg as a SiteAdapter.
+func NewConfigAdapter(cfg *Config) *ConfigAdapter {
+	return &ConfigAdapter{cfg: cfg}
+}
+
+// LoadAdapter loads a Config from path and wraps it as a SiteAdapter.
+func LoadAdapter(path string) (SiteAdapter, error) {
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewConfigAdapter(cfg), nil
+}
+
+func (a *ConfigAdapter) HomepageURL() string {
+	return a.cfg.Homepage
+}
+
+func (a *ConfigAdapter) ProfileURL(id int) string {
+	return fmt.Sprintf(a.cfg.ProfileURLFmt, id)
+}
+
+func (a *ConfigAdapter) ExpectedCount() int {
+	return a.cfg.ExpectedCount
+}
+
+// DiscoverIDs walks links matching IDDiscoverySel (default "a[href]") and
+// extracts IDs with IDRegex.
+func (a *ConfigAdapter) DiscoverIDs(doc *goquery.Document) []int {
+	sel := a.cfg.IDDiscoverySel
+	if sel == "" {
+		sel = "a[href]"
+	}
+
+	idRegex := compileIDRegex(a.cfg.IDRegex)
+	seen := make(map[int]bool)
+	var ids []int
+
+	doc.Find(sel).Each(func(i int, s *goquery.Selection) {
+		href, exists := s.Attr("href")
+		if !exists {
+			return
+		}
+		match := idRegex.FindStringSubmatch(href)
+		if len(match) < 2 {
+			return
+		}
+		id, err := parseID(match[1])
+		if err != nil || seen[id] {
+			return
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	})
+
+	return ids
+}
+
+// ParseProfile applies each field's SelectorRule to doc in order, running
+// postprocess steps on the first non-empty result from Selectors and falling
+// back to Fallbacks if the selectors all miss. When a Config lists several
+// rules for the same field, the first rule that produces a value wins and
+// later ones are skipped — that's how, e.g., the default config tries a
+// "Verdict:" label in the page text before falling back to inferring it
+// from the profile image.
+func (a *ConfigAdapter) ParseProfile(doc *goquery.Document, profile *models.Profile) *models.Profile {
+	for _, rule := range a.cfg.Fields {
+		if fieldIsSet(profile, rule.Field) {
+			continue
+		}
+
+		if rule.Split != "" {
+			for _, item := range applyListRule(doc, rule) {
+				setField(profile, rule.Field, item)
+			}
+			continue
+		}
+
+		if value := applyRule(doc, rule); value != "" {
+			setField(profile, rule.Field, value)
+		}
+	}
+	return profile
+}
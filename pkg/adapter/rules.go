@@ -0,0 +1,339 @@
+package adapter
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+var defaultIDRegex = regexp.MustCompile(`ID=(\d+)`)
+
+func compileIDRegex(pattern string) *regexp.Regexp {
+	if pattern == "" {
+		return defaultIDRegex
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return defaultIDRegex
+	}
+	return re
+}
+
+func parseID(s string) (int, error) {
+	return strconv.Atoi(s)
+}
+
+// applyRule runs rule.Selectors against doc in order, returning the first
+// non-empty (post-processed) match, or the first non-empty Fallback if none
+// of the selectors hit.
+func applyRule(doc *goquery.Document, rule SelectorRule) string {
+	for _, selector := range rule.Selectors {
+		sel := doc.Find(selector).First()
+		if sel.Length() == 0 {
+			continue
+		}
+
+		var raw string
+		if rule.Attr == "" || rule.Attr == "text" {
+			raw = sel.Text()
+		} else {
+			attrVal, exists := sel.Attr(rule.Attr)
+			if !exists {
+				continue
+			}
+			raw = attrVal
+		}
+
+		value := postprocess(raw, rule.Postprocess)
+		if value != "" {
+			return value
+		}
+	}
+
+	for _, fallback := range rule.Fallbacks {
+		if fallback != "" {
+			return fallback
+		}
+	}
+
+	return ""
+}
+
+// applyListRule behaves like applyRule but, on the first selector that
+// matches, splits the post-processed value into multiple list items (via
+// rule.Split) instead of returning one scalar — for list fields like
+// pros/cons, where the site renders a whole block of bullets under one
+// selector.
+func applyListRule(doc *goquery.Document, rule SelectorRule) []string {
+	for _, selector := range rule.Selectors {
+		sel := doc.Find(selector).First()
+		if sel.Length() == 0 {
+			continue
+		}
+
+		var raw string
+		if rule.Attr == "" || rule.Attr == "text" {
+			raw = sel.Text()
+		} else {
+			attrVal, exists := sel.Attr(rule.Attr)
+			if !exists {
+				continue
+			}
+			raw = attrVal
+		}
+
+		block := postprocess(raw, rule.Postprocess)
+		if block == "" {
+			continue
+		}
+
+		if items := splitItems(block, rule.Split); len(items) > 0 {
+			return items
+		}
+	}
+	return nil
+}
+
+// postprocess runs a chain of named steps over value. Steps are "trim",
+// "strip_prefix:<prefix>", "strip_suffix:<suffix>", "collapse_space",
+// "after_label:<label>" (keep only the text after the first case-insensitive
+// occurrence of label), "cut_before_labels:<label>,<label>,..." (truncate at
+// the earliest occurrence of any of labels), "last_word_verdict" (pull a
+// Jew/Not-a-Jew verdict from a sentence ending in the verdict word), and
+// "map:<from>=<to>,<from2>=<to2>,..." (first substring match wins).
+func postprocess(value string, steps []string) string {
+	for _, step := range steps {
+		switch {
+		case step == "trim":
+			value = strings.TrimSpace(value)
+		case step == "collapse_space":
+			value = strings.Join(strings.Fields(value), " ")
+		case step == "last_word_verdict":
+			value = lastWordVerdict(value)
+		case strings.HasPrefix(step, "strip_prefix:"):
+			value = strings.TrimPrefix(value, strings.TrimPrefix(step, "strip_prefix:"))
+		case strings.HasPrefix(step, "strip_suffix:"):
+			value = strings.TrimSuffix(value, strings.TrimPrefix(step, "strip_suffix:"))
+		case strings.HasPrefix(step, "after_label:"):
+			value = afterLabel(value, strings.TrimPrefix(step, "after_label:"))
+		case strings.HasPrefix(step, "cut_before_labels:"):
+			value = cutBeforeLabels(value, strings.Split(strings.TrimPrefix(step, "cut_before_labels:"), ","))
+		case strings.HasPrefix(step, "map:"):
+			value = applyMap(value, strings.TrimPrefix(step, "map:"))
+		}
+	}
+	return strings.TrimSpace(value)
+}
+
+// afterLabel returns the part of value after the first case-insensitive
+// occurrence of label, or value unchanged if label isn't present.
+func afterLabel(value, label string) string {
+	idx := strings.Index(strings.ToLower(value), strings.ToLower(label))
+	if idx < 0 {
+		return value
+	}
+	return value[idx+len(label):]
+}
+
+// cutBeforeLabels truncates value at the earliest occurrence of any of
+// labels (case-insensitive), so a "Verdict:"/"Pros:"/"Cons:" trailer on a
+// description block can be dropped without a dedicated selector for it.
+func cutBeforeLabels(value string, labels []string) string {
+	lower := strings.ToLower(value)
+	cut := len(value)
+	for _, label := range labels {
+		label = strings.ToLower(strings.TrimSpace(label))
+		if label == "" {
+			continue
+		}
+		if idx := strings.Index(lower, label); idx >= 0 && idx < cut {
+			cut = idx
+		}
+	}
+	return value[:cut]
+}
+
+// lastWordVerdict extracts a Jew/Not-a-Jew verdict from a sentence ending in
+// the verdict word, as jewornotjew.com's meta description does (e.g.
+// "Is Albert Einstein a Jew? ... Einstein is a Jew.").
+func lastWordVerdict(value string) string {
+	value = strings.TrimSpace(value)
+	if !strings.HasSuffix(value, ".") {
+		return ""
+	}
+	words := strings.Fields(value)
+	if len(words) < 3 {
+		return ""
+	}
+
+	// "Not a Jew." ends in the word "Jew" just like a plain "Jew." verdict
+	// does, so a negation anywhere in the trailing "Not a Jew" has to be
+	// checked before falling back to the last word alone.
+	for _, w := range words[len(words)-3:] {
+		if strings.EqualFold(strings.Trim(w, "."), "not") {
+			return "Not a Jew"
+		}
+	}
+
+	last := strings.TrimSuffix(words[len(words)-1], ".")
+	switch {
+	case last == "Jew" || last == "Jewish":
+		return "Jew"
+	default:
+		return ""
+	}
+}
+
+// applyMap returns to for the first "from=to" pair (from mapping, a
+// comma-separated list) whose from is a substring of value, or "" if none match.
+func applyMap(value, mapping string) string {
+	for _, pair := range strings.Split(mapping, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		if strings.Contains(value, kv[0]) {
+			return kv[1]
+		}
+	}
+	return ""
+}
+
+// bullets are the characters splitItems recognizes as list markers, tried in
+// order before falling back to newline- or sentence-based splitting.
+var bullets = []string{"•", "-", "★", "✓", "✔", "*", "→", "⇒", "⟹", "⇾", "⟶"}
+
+// splitItems breaks block into list items using the named strategy. The only
+// strategy so far is "bullets": split on a bullet character if one is
+// present, else on newlines, else on numbered points, else on
+// sentence/semicolon boundaries, falling back to the whole block as a single
+// item. Empty, very short, and duplicate items are dropped.
+func splitItems(block, strategy string) []string {
+	if strategy != "bullets" {
+		return []string{block}
+	}
+
+	var items []string
+
+	hasBullets := false
+	for _, bullet := range bullets {
+		if strings.Contains(block, bullet) {
+			hasBullets = true
+			for _, part := range strings.Split(block, bullet) {
+				if part = strings.TrimSpace(part); part != "" {
+					items = append(items, part)
+				}
+			}
+			break
+		}
+	}
+
+	if !hasBullets && strings.Contains(block, "\n") {
+		for _, line := range strings.Split(block, "\n") {
+			line = strings.TrimSpace(line)
+			for _, bullet := range bullets {
+				if strings.HasPrefix(line, bullet) {
+					line = strings.TrimSpace(strings.TrimPrefix(line, bullet))
+					break
+				}
+			}
+			if len(line) > 2 {
+				items = append(items, line)
+			}
+		}
+	}
+
+	if len(items) == 0 {
+		if numberRegex.MatchString(block) {
+			for _, part := range numberRegex.Split(block, -1) {
+				if part = strings.TrimSpace(part); len(part) > 2 {
+					items = append(items, part)
+				}
+			}
+		}
+	}
+
+	if len(items) == 0 && len(block) > 15 && (strings.Contains(block, ". ") || strings.Contains(block, "; ")) {
+		if parts := strings.Split(block, ". "); len(parts) > 1 {
+			for _, part := range parts {
+				part = strings.TrimSpace(part)
+				if len(part) > 10 {
+					if len(part) > 20 && part[0] >= 'A' && part[0] <= 'Z' {
+						part += "."
+					}
+					items = append(items, part)
+				}
+			}
+		} else {
+			for _, part := range strings.Split(block, "; ") {
+				if part = strings.TrimSpace(part); len(part) > 5 {
+					items = append(items, part)
+				}
+			}
+		}
+	}
+
+	if len(items) == 0 {
+		items = append(items, block)
+	}
+
+	seen := make(map[string]bool)
+	var deduped []string
+	for _, item := range items {
+		if item == "" || len(item) < 3 || seen[item] {
+			continue
+		}
+		seen[item] = true
+		deduped = append(deduped, item)
+	}
+	return deduped
+}
+
+var numberRegex = regexp.MustCompile(`(\d+\.\s+)`)
+
+// fieldIsSet reports whether profile already has a value for field, so
+// ParseProfile can treat a Config's repeated rules for one field as a
+// fallback chain instead of letting a later rule overwrite an earlier hit.
+func fieldIsSet(profile *models.Profile, field string) bool {
+	switch strings.ToLower(field) {
+	case "name":
+		return profile.Name != ""
+	case "verdict":
+		return profile.Verdict != ""
+	case "description":
+		return profile.Description != ""
+	case "category":
+		return profile.Category != ""
+	case "image_url", "imageurl":
+		return profile.ImageURL != ""
+	case "pros":
+		return len(profile.Pros) > 0
+	case "cons":
+		return len(profile.Cons) > 0
+	default:
+		return false
+	}
+}
+
+// setField assigns value to the Profile field named by field (case-insensitive).
+func setField(profile *models.Profile, field, value string) {
+	switch strings.ToLower(field) {
+	case "name":
+		profile.Name = value
+	case "verdict":
+		profile.Verdict = value
+	case "description":
+		profile.Description = value
+	case "category":
+		profile.Category = value
+	case "image_url", "imageurl":
+		profile.ImageURL = value
+	case "pros":
+		profile.Pros = append(profile.Pros, value)
+	case "cons":
+		profile.Cons = append(profile.Cons, value)
+	}
+}
@@ -0,0 +1,145 @@
+package adapter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+func TestPostprocess(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		steps []string
+		want  string
+	}{
+		{"trim", "  hello  ", []string{"trim"}, "hello"},
+		{"collapse_space", "a   b\tc", []string{"collapse_space"}, "a b c"},
+		{"strip_prefix", "Verdict: Jew", []string{"strip_prefix:Verdict: "}, "Jew"},
+		{"strip_suffix", "Jew.", []string{"strip_suffix:."}, "Jew"},
+		{"after_label", "Category: Science", []string{"after_label:Category:"}, "Science"},
+		{"cut_before_labels", "A description. Pros: x", []string{"cut_before_labels:Pros:,Cons:"}, "A description."},
+		{"chained steps", "  Verdict: Jew  ", []string{"trim", "strip_prefix:Verdict: "}, "Jew"},
+		{"unknown step is a no-op", "value", []string{"not_a_real_step"}, "value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := postprocess(tt.value, tt.steps); got != tt.want {
+				t.Errorf("postprocess(%q, %v) = %q, want %q", tt.value, tt.steps, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLastWordVerdict(t *testing.T) {
+	tests := []struct {
+		name, value, want string
+	}{
+		{"ends in Jew", "Is Albert Einstein a Jew? Einstein is a Jew.", "Jew"},
+		{"ends in Jewish", "Is he Jewish? He is Jewish.", "Jew"},
+		{"ends in Not a Jew", "Is she a Jew? She is Not a Jew.", "Not a Jew"},
+		{"no trailing period", "Einstein is a Jew", ""},
+		{"too short", "Jew.", ""},
+		{"unrecognized last word", "Einstein is a genius.", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lastWordVerdict(tt.value); got != tt.want {
+				t.Errorf("lastWordVerdict(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyMap(t *testing.T) {
+	mapping := "Jew=Jew,Not a Jew=Not a Jew,Maybe=Unclear"
+	tests := []struct {
+		name, value, want string
+	}{
+		{"first match wins", "He is Not a Jew by most accounts", "Jew"},
+		{"no match", "totally unrelated text", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applyMap(tt.value, mapping); got != tt.want {
+				t.Errorf("applyMap(%q, %q) = %q, want %q", tt.value, mapping, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCutBeforeLabels(t *testing.T) {
+	value := "A long description of the person. Pros: smart. Cons: lazy."
+	got := cutBeforeLabels(value, []string{"Pros:", "Cons:"})
+	want := "A long description of the person. "
+	if got != want {
+		t.Errorf("cutBeforeLabels() = %q, want %q", got, want)
+	}
+
+	if got := cutBeforeLabels(value, []string{""}); got != value {
+		t.Errorf("cutBeforeLabels() with an empty label should leave value unchanged, got %q", got)
+	}
+}
+
+func TestSplitItemsBullets(t *testing.T) {
+	tests := []struct {
+		name  string
+		block string
+		want  []string
+	}{
+		{
+			"bullet-separated",
+			"• Born to Jewish parents • Bar Mitzvah'd • Supports Israel",
+			[]string{"Born to Jewish parents", "Bar Mitzvah'd", "Supports Israel"},
+		},
+		{
+			"newline-separated",
+			"Born to Jewish parents\nBar Mitzvah'd\nSupports Israel",
+			[]string{"Born to Jewish parents", "Bar Mitzvah'd", "Supports Israel"},
+		},
+		{
+			"duplicates are dropped",
+			"• one • one • two",
+			[]string{"one", "two"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitItems(tt.block, "bullets"); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitItems(%q, bullets) = %v, want %v", tt.block, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitItemsUnknownStrategy(t *testing.T) {
+	if got := splitItems("one block", "unknown"); !reflect.DeepEqual(got, []string{"one block"}) {
+		t.Errorf("splitItems with an unknown strategy should return the block as-is, got %v", got)
+	}
+}
+
+func TestFieldIsSetAndSetField(t *testing.T) {
+	p := &models.Profile{}
+
+	for _, field := range []string{"name", "verdict", "description", "category", "image_url", "pros", "cons"} {
+		if fieldIsSet(p, field) {
+			t.Errorf("fieldIsSet(%q) = true on a zero-value Profile, want false", field)
+		}
+	}
+
+	setField(p, "Name", "Albert Einstein")
+	setField(p, "Verdict", "Jew")
+	setField(p, "Pros", "Born to Jewish parents")
+	setField(p, "Pros", "Bar Mitzvah'd")
+
+	if p.Name != "Albert Einstein" {
+		t.Errorf("setField did not set Name, got %q", p.Name)
+	}
+	if !fieldIsSet(p, "name") || !fieldIsSet(p, "verdict") {
+		t.Error("fieldIsSet should report true after setField")
+	}
+	if want := []string{"Born to Jewish parents", "Bar Mitzvah'd"}; !reflect.DeepEqual(p.Pros, want) {
+		t.Errorf("setField(Pros) appended = %v, want %v", p.Pros, want)
+	}
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/marcusziade/jewornotjew/pkg/activitypub"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// apContentType is the media type ActivityPub responses are served as.
+// Mastodon and most other implementations also accept plain
+// application/json, but this is the type the spec actually names.
+const apContentType = "application/activity+json; charset=utf-8"
+
+// writeActivityPub encodes v as JSON, signs it with s.apSigner if one is
+// configured, and writes it as an ActivityPub response. Signing has to
+// happen before the body is written, since the Digest header covers the
+// exact bytes served. keyID must match the signing actor's published
+// PublicKey.Id (see apActorKeyID).
+func writeActivityPub(s *Server, w http.ResponseWriter, r *http.Request, v interface{}, keyID string) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to encode response: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	if s.apSigner != nil {
+		if err := s.apSigner.SignResponse(w, r, body, keyID); err != nil {
+			http.Error(w, fmt.Sprintf("Failed to sign response: %v", err), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", apContentType)
+	w.Write(body)
+}
+
+// apActorKeyID returns the PublicKey.Id BuildActor publishes for name,
+// the keyId a signature over that actor's responses must use to verify.
+func (s *Server) apActorKeyID(name string) string {
+	return s.baseURL + "/ap/actor/" + name + "#main-key"
+}
+
+// apPublicKeyPEM returns s's public key PEM if ActivityPub signing is
+// configured, or "" otherwise, so BuildActor can omit the publicKey field
+// when there's no key to publish.
+func (s *Server) apPublicKeyPEM() string {
+	if s.apSigner == nil {
+		return ""
+	}
+	pem, err := s.apSigner.PublicKeyPEM()
+	if err != nil {
+		return ""
+	}
+	return pem
+}
+
+// apActor handles GET /ap/actor/{name}, rendering profile as an
+// ActivityStreams actor.
+func (s *Server) apActor(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	var profile *models.Profile
+	err := s.timeQuery("GetProfile", func() error {
+		var err error
+		profile, err = s.db.GetProfile(name)
+		return err
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, fmt.Sprintf("Profile not found: %s", name), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get profile: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	actor := activitypub.BuildActor(profile, s.baseURL, s.apPublicKeyPEM())
+	writeActivityPub(s, w, r, actor, s.apActorKeyID(name))
+}
+
+// apOutbox handles GET /ap/outbox/{name}, rendering profile's single
+// known update as an OrderedCollection.
+func (s *Server) apOutbox(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	var profile *models.Profile
+	err := s.timeQuery("GetProfile", func() error {
+		var err error
+		profile, err = s.db.GetProfile(name)
+		return err
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, fmt.Sprintf("Profile not found: %s", name), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get profile: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	outbox := activitypub.BuildOutbox(profile, s.baseURL)
+	writeActivityPub(s, w, r, outbox, s.apActorKeyID(name))
+}
+
+// webfinger handles GET /.well-known/webfinger?resource=acct:name@host,
+// resolving the acct to its actor if a profile named name exists.
+func (s *Server) webfinger(w http.ResponseWriter, r *http.Request) {
+	resource := r.URL.Query().Get("resource")
+	name, host, ok := parseAcct(resource)
+	if !ok {
+		http.Error(w, "Query parameter 'resource' must be acct:name@host", http.StatusBadRequest)
+		return
+	}
+
+	err := s.timeQuery("GetProfile", func() error {
+		_, err := s.db.GetProfile(name)
+		return err
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, fmt.Sprintf("Profile not found: %s", name), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to get profile: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	jrd := activitypub.BuildWebFinger(name, host, s.baseURL)
+	body, err := json.Marshal(jrd)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to encode response: %v", err), http.StatusInternalServerError)
+		return
+	}
+	if s.apSigner != nil {
+		if err := s.apSigner.SignResponse(w, r, body, s.apActorKeyID(name)); err != nil {
+			http.Error(w, fmt.Sprintf("Failed to sign response: %v", err), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "application/jrd+json; charset=utf-8")
+	w.Write(body)
+}
+
+// parseAcct splits a WebFinger "acct:name@host" resource into its name
+// and host parts.
+func parseAcct(resource string) (name, host string, ok bool) {
+	rest := strings.TrimPrefix(resource, "acct:")
+	if rest == resource {
+		return "", "", false
+	}
+	name, host, found := strings.Cut(rest, "@")
+	if !found || name == "" || host == "" {
+		return "", "", false
+	}
+	return name, host, true
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/marcusziade/jewornotjew/pkg/feed"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// maxFeedItems caps how many of the newest profiles a feed includes.
+const maxFeedItems = 50
+
+// profileTime parses a models.Profile timestamp (written as
+// time.RFC3339 throughout pkg/db and pkg/client), treating a parse
+// failure as the zero time so a malformed row sorts last rather than
+// failing the whole feed.
+func profileTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// profileFeedItem converts profile into a feed.Item: title is the name,
+// categories are [Category, Verdict], the description summarizes Pros and
+// Cons, the link points at the REST profile endpoint, and the GUID is a
+// stable hash of URL so it survives a profile being renamed or re-scraped.
+func profileFeedItem(profile *models.Profile) *feed.Item {
+	var desc strings.Builder
+	if len(profile.Pros) > 0 {
+		fmt.Fprintf(&desc, "Pros: %s. ", strings.Join(profile.Pros, "; "))
+	}
+	if len(profile.Cons) > 0 {
+		fmt.Fprintf(&desc, "Cons: %s.", strings.Join(profile.Cons, "; "))
+	}
+
+	guid := sha256.Sum256([]byte(profile.URL))
+
+	return &feed.Item{
+		Title:       profile.Name,
+		Link:        "/api/profiles/" + url.PathEscape(profile.Name),
+		Description: desc.String(),
+		Id:          hex.EncodeToString(guid[:]),
+		Categories:  []string{profile.Category, profile.Verdict},
+		Created:     profileTime(profile.CreatedAt),
+		Updated:     profileTime(profile.UpdatedAt),
+	}
+}
+
+// buildFeed sorts profiles by UpdatedAt descending, caps the result at
+// maxFeedItems, and wraps them in a feed.Feed titled title.
+func buildFeed(title, link string, profiles []*models.Profile) *feed.Feed {
+	sorted := make([]*models.Profile, len(profiles))
+	copy(sorted, profiles)
+	sort.Slice(sorted, func(i, j int) bool {
+		return profileTime(sorted[i].UpdatedAt).After(profileTime(sorted[j].UpdatedAt))
+	})
+	if len(sorted) > maxFeedItems {
+		sorted = sorted[:maxFeedItems]
+	}
+
+	f := &feed.Feed{
+		Title:       title,
+		Link:        link,
+		Description: title,
+	}
+	for _, profile := range sorted {
+		item := profileFeedItem(profile)
+		f.Items = append(f.Items, item)
+		if item.Updated.After(f.Updated) {
+			f.Updated = item.Updated
+		}
+	}
+	return f
+}
+
+// writeFeed renders f as format ("rss" or "atom"), honoring conditional
+// GET (If-Modified-Since / If-None-Match) driven by f.Updated so
+// aggregators polling on a schedule don't re-fetch an unchanged feed.
+func writeFeed(w http.ResponseWriter, r *http.Request, f *feed.Feed, format string) {
+	etag := fmt.Sprintf("%q", f.Updated.UTC().Format(time.RFC3339))
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Last-Modified", f.Updated.UTC().Format(http.TimeFormat))
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	if since, err := time.Parse(http.TimeFormat, r.Header.Get("If-Modified-Since")); err == nil {
+		if !f.Updated.UTC().Truncate(time.Second).After(since) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	var (
+		body []byte
+		err  error
+	)
+	switch format {
+	case "atom":
+		w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+		body, err = f.ToAtom()
+	default:
+		w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+		body, err = f.ToRSS()
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to render feed: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(body)
+}
+
+// feedAll handles GET /api/feed.rss and /api/feed.atom, covering every
+// profile.
+func (s *Server) feedAll(format string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var profiles []*models.Profile
+		err := s.timeQuery("ListProfiles", func() error {
+			var err error
+			profiles, err = s.db.ListProfiles()
+			return err
+		})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to list profiles: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		f := buildFeed("JewOrNotJew profiles", "/api/feed."+format, profiles)
+		writeFeed(w, r, f, format)
+	}
+}
+
+// feedByCategory handles GET /api/category/{name}/feed.rss and
+// /api/category/{name}/feed.atom, covering just the profiles in one
+// category.
+func (s *Server) feedByCategory(format string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		category := mux.Vars(r)["name"]
+
+		var all []*models.Profile
+		err := s.timeQuery("ListProfiles", func() error {
+			var err error
+			all, err = s.db.ListProfiles()
+			return err
+		})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to list profiles: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		var profiles []*models.Profile
+		for _, profile := range all {
+			if strings.EqualFold(profile.Category, category) {
+				profiles = append(profiles, profile)
+			}
+		}
+
+		link := "/api/category/" + url.PathEscape(category) + "/feed." + format
+		f := buildFeed(fmt.Sprintf("JewOrNotJew profiles: %s", category), link, profiles)
+		writeFeed(w, r, f, format)
+	}
+}
@@ -0,0 +1,256 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/marcusziade/jewornotjew/pkg/db"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// graphqlRequest is the standard GraphQL-over-HTTP POST body GraphiQL and
+// every other client send.
+type graphqlRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// textItem backs the GraphQL Pro and Con types, which both just wrap a
+// single string column (see the pros/cons tables in migrations/).
+type textItem struct {
+	Text string `json:"text"`
+}
+
+func textItems(ss []string) []textItem {
+	items := make([]textItem, len(ss))
+	for i, s := range ss {
+		items[i] = textItem{Text: s}
+	}
+	return items
+}
+
+var proType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Pro",
+	Fields: graphql.Fields{
+		"text": &graphql.Field{Type: graphql.String},
+	},
+})
+
+var conType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Con",
+	Fields: graphql.Fields{
+		"text": &graphql.Field{Type: graphql.String},
+	},
+})
+
+var profileType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Profile",
+	Fields: graphql.Fields{
+		"name":        &graphql.Field{Type: graphql.String},
+		"url":         &graphql.Field{Type: graphql.String},
+		"verdict":     &graphql.Field{Type: graphql.String},
+		"description": &graphql.Field{Type: graphql.String},
+		"score":       &graphql.Field{Type: graphql.Float},
+		"category":    &graphql.Field{Type: graphql.String},
+		"imageUrl":    &graphql.Field{Type: graphql.String},
+		"createdAt":   &graphql.Field{Type: graphql.String},
+		"updatedAt":   &graphql.Field{Type: graphql.String},
+		"pros": &graphql.Field{
+			Type: graphql.NewList(proType),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				profile := p.Source.(*models.Profile)
+				return textItems(profile.Pros), nil
+			},
+		},
+		"cons": &graphql.Field{
+			Type: graphql.NewList(conType),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				profile := p.Source.(*models.Profile)
+				return textItems(profile.Cons), nil
+			},
+		},
+	},
+})
+
+// searchResultType mirrors db.FTSSearchResult for the search root query.
+var searchResultType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "SearchResult",
+	Fields: graphql.Fields{
+		"profile": &graphql.Field{Type: profileType},
+		"rank":    &graphql.Field{Type: graphql.Float},
+		"snippet": &graphql.Field{Type: graphql.String},
+	},
+})
+
+// newGraphQLSchema builds the schema's root Query type, with every
+// resolver delegating to store - the same db.Store the REST handlers use,
+// so GraphQL and REST never see a different view of the data. Because
+// ListProfiles/SearchProfiles/SearchProfilesFTS/QueryProfilesFiltered
+// already batch-load pros and cons for their whole result set in one extra
+// query (see getProsConsBatch in pkg/db), a nested
+// `{ profiles { pros { text } } }` selection doesn't cost a query per
+// profile - graphql-go just reads the field already sitting on the
+// resolved *models.Profile.
+func newGraphQLSchema(store db.Store) (graphql.Schema, error) {
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"profile": &graphql.Field{
+				Type: profileType,
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return store.GetProfile(p.Args["name"].(string))
+				},
+			},
+			"profiles": &graphql.Field{
+				Type: graphql.NewList(profileType),
+				Args: graphql.FieldConfigArgument{
+					"category":     &graphql.ArgumentConfig{Type: graphql.String},
+					"verdict":      &graphql.ArgumentConfig{Type: graphql.String},
+					"scoreGte":     &graphql.ArgumentConfig{Type: graphql.Float},
+					"nameContains": &graphql.ArgumentConfig{Type: graphql.String},
+					"sortBy":       &graphql.ArgumentConfig{Type: graphql.String},
+					"first":        &graphql.ArgumentConfig{Type: graphql.Int},
+					"after":        &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return store.QueryProfilesFiltered(profileFilterFromArgs(p.Args))
+				},
+			},
+			"search": &graphql.Field{
+				Type: graphql.NewList(searchResultType),
+				Args: graphql.FieldConfigArgument{
+					"query": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"mode":  &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					query := p.Args["query"].(string)
+					if mode, _ := p.Args["mode"].(string); mode == "fts" {
+						return store.SearchProfilesFTS(query, defaultSearchLimit, 0)
+					}
+
+					profiles, err := store.SearchProfiles(query)
+					if err != nil {
+						return nil, err
+					}
+					results := make([]*db.FTSSearchResult, len(profiles))
+					for i, profile := range profiles {
+						results[i] = &db.FTSSearchResult{Profile: profile}
+					}
+					return results, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+// profileFilterFromArgs builds a db.ProfileFilter from the profiles
+// field's arguments, pushing every filter/sort/pagination decision down to
+// SQL instead of loading the whole table and slicing it in memory.
+//
+// after is a plain decimal offset rather than an opaque Relay cursor -
+// nothing else in this API paginates that way, and a client gains nothing
+// from a cursor that base64-encodes the same offset it could pass
+// directly. A non-empty after without a first defaults the page size to
+// defaultSearchLimit, the same default /api/search falls back to.
+func profileFilterFromArgs(args map[string]interface{}) db.ProfileFilter {
+	var filter db.ProfileFilter
+	if v, ok := args["category"].(string); ok {
+		filter.Category = v
+	}
+	if v, ok := args["verdict"].(string); ok {
+		filter.Verdict = v
+	}
+	if v, ok := args["scoreGte"].(float64); ok {
+		filter.ScoreGte = &v
+	}
+	if v, ok := args["nameContains"].(string); ok {
+		filter.NameContains = v
+	}
+	if v, ok := args["sortBy"].(string); ok {
+		filter.SortBy = v
+	}
+	if v, ok := args["first"].(int); ok && v > 0 {
+		filter.Limit = v
+	}
+	if v, ok := args["after"].(string); ok && v != "" {
+		if offset, err := strconv.Atoi(v); err == nil && offset > 0 {
+			filter.Offset = offset
+			if filter.Limit == 0 {
+				filter.Limit = defaultSearchLimit
+			}
+		}
+	}
+	return filter
+}
+
+// handleGraphQL handles POST /graphql: a standard GraphQL-over-HTTP
+// request, executed against s's schema.
+func (s *Server) handleGraphQL(w http.ResponseWriter, r *http.Request) {
+	var req graphqlRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:         s.graphqlSchema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        r.Context(),
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to encode response: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+// graphiqlHTML serves a GraphiQL playground pointed at /graphql, pulling
+// its assets from a CDN rather than vendoring the React app - this handler
+// has no other job than exploration in a browser.
+const graphiqlHTML = `<!DOCTYPE html>
+<html>
+<head>
+	<title>JewOrNotJew GraphQL Playground</title>
+	<link href="https://unpkg.com/graphiql/graphiql.min.css" rel="stylesheet" />
+</head>
+<body style="margin:0;">
+	<div id="graphiql" style="height:100vh;"></div>
+	<script src="https://unpkg.com/react/umd/react.production.min.js"></script>
+	<script src="https://unpkg.com/react-dom/umd/react-dom.production.min.js"></script>
+	<script src="https://unpkg.com/graphiql/graphiql.min.js"></script>
+	<script>
+		function graphQLFetcher(graphQLParams) {
+			return fetch('/graphql', {
+				method: 'post',
+				headers: { 'Content-Type': 'application/json' },
+				body: JSON.stringify(graphQLParams),
+			}).then(function (response) {
+				return response.json();
+			});
+		}
+		ReactDOM.render(
+			React.createElement(GraphiQL, { fetcher: graphQLFetcher }),
+			document.getElementById('graphiql'),
+		);
+	</script>
+</body>
+</html>
+`
+
+// handleGraphQLUI serves GET /graphql/ui.
+func (s *Server) handleGraphQLUI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html")
+	fmt.Fprint(w, graphiqlHTML)
+}
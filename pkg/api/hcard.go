@@ -0,0 +1,79 @@
+package api
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// hCardTemplate renders a models.Profile as a microformats2 h-card, with
+// any recorded webmentions underneath so third-party sites linking to the
+// profile become visible on the page itself.
+var hCardTemplate = template.Must(template.New("hcard").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Profile.Name}}</title>
+</head>
+<body>
+<article class="h-card">
+  <h1 class="p-name">{{.Profile.Name}}</h1>
+  <a class="u-url" href="{{.Profile.URL}}">{{.Profile.URL}}</a>
+  {{if .Profile.ImageURL}}<img class="u-photo" src="{{.Profile.ImageURL}}" alt="{{.Profile.Name}}">{{end}}
+  <p class="p-category">{{.Profile.Category}}</p>
+  <p class="p-note">{{.Profile.Description}}</p>
+  <div class="p-x-verdict">{{.Profile.Verdict}}</div>
+</article>
+{{if .Mentions}}
+<section class="webmentions">
+  <h2>Mentions</h2>
+  {{range .Mentions}}
+  <div class="h-cite u-{{.Type}}-of">
+    {{if .AuthorName}}<span class="p-author h-card">{{.AuthorName}}</span>{{end}}
+    <a class="u-url" href="{{.SourceURL}}">{{.SourceURL}}</a>
+    {{if .Content}}<p class="e-content">{{.Content}}</p>{{end}}
+  </div>
+  {{end}}
+</section>
+{{end}}
+</body>
+</html>
+`))
+
+// hCardData is hCardTemplate's input.
+type hCardData struct {
+	Profile  *models.Profile
+	Mentions []*models.Webmention
+}
+
+// profileHCard handles GET /profiles/{name}, rendering profile as an
+// h-card HTML page alongside its recorded webmentions. This is distinct
+// from GET /api/profiles/{name}, which serves the same profile as JSON.
+func (s *Server) profileHCard(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	var profile *models.Profile
+	err := s.timeQuery("GetProfile", func() error {
+		var err error
+		profile, err = s.db.GetProfile(name)
+		return err
+	})
+	if err != nil {
+		http.Error(w, "Profile not found: "+name, http.StatusNotFound)
+		return
+	}
+
+	mentions, err := s.db.ListWebmentions(name)
+	if err != nil {
+		http.Error(w, "Failed to load webmentions: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := hCardTemplate.Execute(w, hCardData{Profile: profile, Mentions: mentions}); err != nil {
+		http.Error(w, "Failed to render profile: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/marcusziade/jewornotjew/pkg/mastoapi"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// writeMastoError writes a Mastodon-shaped {"error": "..."} body, the
+// format its client libraries expect on a non-2xx response.
+func writeMastoError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
+
+// mastoAccountByID handles GET /api/v1/accounts/{id}, where id is
+// mastoapi.AccountID(profile.URL). There's no id column to index on, so
+// this scans every profile the same way feedByCategory scans for a
+// category match.
+func (s *Server) mastoAccountByID(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	var profiles []*models.Profile
+	err := s.timeQuery("ListProfiles", func() error {
+		var err error
+		profiles, err = s.db.ListProfiles()
+		return err
+	})
+	if err != nil {
+		writeMastoError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	for _, profile := range profiles {
+		if mastoapi.AccountID(profile.URL) == id {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(mastoapi.BuildAccount(profile))
+			return
+		}
+	}
+	writeMastoError(w, http.StatusNotFound, "Record not found")
+}
+
+// mastoAccountLookup handles GET /api/v1/accounts/lookup?acct={name},
+// resolving by the same slug BuildAccount derives Username/Acct from.
+func (s *Server) mastoAccountLookup(w http.ResponseWriter, r *http.Request) {
+	acct := r.URL.Query().Get("acct")
+	if acct == "" {
+		writeMastoError(w, http.StatusBadRequest, "Query parameter 'acct' is required")
+		return
+	}
+	// A client may pass "name@host"; only the local part identifies a
+	// profile here, since every account belongs to this one instance.
+	acct, _, _ = strings.Cut(acct, "@")
+	wantSlug := mastoapi.Slug(acct)
+
+	var profiles []*models.Profile
+	err := s.timeQuery("ListProfiles", func() error {
+		var err error
+		profiles, err = s.db.ListProfiles()
+		return err
+	})
+	if err != nil {
+		writeMastoError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	for _, profile := range profiles {
+		if mastoapi.Slug(profile.Name) == wantSlug {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(mastoapi.BuildAccount(profile))
+			return
+		}
+	}
+	writeMastoError(w, http.StatusNotFound, "Record not found")
+}
+
+// mastoAccountSearch handles GET /api/v1/accounts/search?q=..., reusing
+// the same substring search /api/search's default mode runs.
+func (s *Server) mastoAccountSearch(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		writeMastoError(w, http.StatusBadRequest, "Query parameter 'q' is required")
+		return
+	}
+
+	var profiles []*models.Profile
+	err := s.timeQuery("SearchProfiles", func() error {
+		var err error
+		profiles, err = s.db.SearchProfiles(query)
+		return err
+	})
+	if err != nil {
+		writeMastoError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	accounts := make([]*mastoapi.Account, 0, len(profiles))
+	for _, profile := range profiles {
+		accounts = append(accounts, mastoapi.BuildAccount(profile))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(accounts)
+}
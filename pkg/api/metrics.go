@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// serverMetrics holds the Prometheus collectors exposed at /metrics,
+// registered on their own registry so scraping it doesn't also pull in the
+// default process/Go runtime collectors (see pkg/metrics, which does the
+// same for the scraper).
+type serverMetrics struct {
+	registry *prometheus.Registry
+
+	// requestsTotal counts requests, labeled by method, path, and status.
+	requestsTotal *prometheus.CounterVec
+
+	// dbQueryDuration tracks how long Store calls take, labeled by the
+	// method name (e.g. "ListProfiles", "SearchProfilesFTS").
+	dbQueryDuration *prometheus.HistogramVec
+
+	// profilesTotal is the number of profiles currently in the store, set
+	// each time listProfiles runs.
+	profilesTotal prometheus.Gauge
+}
+
+// newServerMetrics returns a serverMetrics bundle ready to register on a
+// route via Handler.
+func newServerMetrics() *serverMetrics {
+	registry := prometheus.NewRegistry()
+
+	m := &serverMetrics{
+		registry: registry,
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "api_requests_total",
+			Help: "HTTP requests handled by the API server, by method, path, and status.",
+		}, []string{"method", "path", "status"}),
+		dbQueryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "api_db_query_duration_seconds",
+			Help:    "Latency of Store calls made while handling a request.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method"}),
+		profilesTotal: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "api_profiles_total",
+			Help: "Number of profiles currently in the store.",
+		}),
+	}
+
+	registry.MustRegister(m.requestsTotal, m.dbQueryDuration, m.profilesTotal)
+	return m
+}
+
+// Handler returns the /metrics endpoint exposing m's collectors.
+func (m *serverMetrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,238 @@
+package api
+
+import (
+	"compress/gzip"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// middleware wraps an http.HandlerFunc with cross-cutting behavior. chain
+// applies mws in the order given, so the first middleware is the outermost
+// one a request passes through.
+type middleware func(http.HandlerFunc) http.HandlerFunc
+
+func chain(next http.HandlerFunc, mws ...middleware) http.HandlerFunc {
+	for i := len(mws) - 1; i >= 0; i-- {
+		next = mws[i](next)
+	}
+	return next
+}
+
+// statusWriter wraps http.ResponseWriter to capture the status code and
+// byte count withLogging needs to report, since neither is otherwise
+// observable after the handler returns.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
+
+// withLogging logs one Apache-style access line per request: method, path,
+// status, response size, and latency.
+func withLogging(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w}
+		next(sw, r)
+		log.Printf("%s %s %d %dB %s", r.Method, r.URL.Path, sw.status, sw.bytes, time.Since(start))
+	}
+}
+
+// gzipWriter wraps http.ResponseWriter so writes pass through a
+// gzip.Writer instead of going straight to the client.
+type gzipWriter struct {
+	http.ResponseWriter
+	gz io.Writer
+}
+
+func (w *gzipWriter) Write(b []byte) (int, error) {
+	return w.gz.Write(b)
+}
+
+// withGzip compresses the response body when the client advertises gzip
+// support via Accept-Encoding, the same opt-in NYTimes/gziphandler uses.
+func withGzip(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			next(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		gz := gzip.NewWriter(w)
+		defer gz.Close()
+		next(&gzipWriter{ResponseWriter: w, gz: gz}, r)
+	}
+}
+
+// withCORS sets Access-Control-Allow-Origin for requests whose Origin
+// header matches one of origins (or any origin, if origins contains "*"),
+// and short-circuits preflight OPTIONS requests.
+func withCORS(origins []string) middleware {
+	allowAll := false
+	for _, o := range origins {
+		if o == "*" {
+			allowAll = true
+			break
+		}
+	}
+
+	allowed := func(origin string) bool {
+		if allowAll {
+			return true
+		}
+		for _, o := range origins {
+			if o == origin {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin != "" && allowed(origin) {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+			}
+
+			if r.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			next(w, r)
+		}
+	}
+}
+
+// tokenBucket is a per-IP request limiter, the same shape as
+// pkg/politeness's per-host limiter but keyed by client IP instead of host.
+type tokenBucket struct {
+	tokens     float64
+	rate       float64
+	burst      float64
+	lastRefill time.Time
+}
+
+func (b *tokenBucket) allow(now time.Time) bool {
+	elapsed := now.Sub(b.lastRefill).Seconds()
+	b.lastRefill = now
+	b.tokens += elapsed * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// ipRateLimiter limits requests per client IP using a token bucket per IP,
+// created lazily on first sight.
+type ipRateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+	rate    float64
+	burst   float64
+}
+
+// newIPRateLimiter returns a limiter allowing each IP requestsPerSecond
+// sustained requests with bursts up to burst.
+func newIPRateLimiter(requestsPerSecond float64, burst int) *ipRateLimiter {
+	return &ipRateLimiter{
+		buckets: make(map[string]*tokenBucket),
+		rate:    requestsPerSecond,
+		burst:   float64(burst),
+	}
+}
+
+func (l *ipRateLimiter) allow(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[ip]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, rate: l.rate, burst: l.burst, lastRefill: time.Now()}
+		l.buckets[ip] = b
+	}
+	return b.allow(time.Now())
+}
+
+// withRateLimit rejects requests beyond s's configured per-IP rate with
+// 429 Too Many Requests. A nil rate limiter (the default) disables it.
+func (s *Server) withRateLimit(next http.HandlerFunc) http.HandlerFunc {
+	if s.rateLimiter == nil {
+		return next
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.rateLimiter.allow(clientIP(r)) {
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// withAuth rejects write requests whose Authorization header doesn't carry
+// s's configured bearer token. A blank authToken (the default) leaves the
+// write path open, which is only appropriate in local development or
+// behind a trusted proxy.
+func (s *Server) withAuth(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.authToken != "" && r.Header.Get("Authorization") != "Bearer "+s.authToken {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// withMetrics records s's requestsTotal counter for every request, labeled
+// by method, path, and the response status withLogging's statusWriter
+// captures.
+func (s *Server) withMetrics(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sw := &statusWriter{ResponseWriter: w}
+		next(sw, r)
+		s.metrics.requestsTotal.WithLabelValues(r.Method, r.URL.Path, strconv.Itoa(sw.status)).Inc()
+	}
+}
+
+// clientIP returns the request's IP, preferring X-Forwarded-For (set by a
+// reverse proxy) over RemoteAddr.
+func clientIP(r *http.Request) string {
+	if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+		return strings.TrimSpace(strings.Split(fwd, ",")[0])
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
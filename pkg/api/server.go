@@ -5,33 +5,152 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/graphql-go/graphql"
+	"github.com/marcusziade/jewornotjew/pkg/activitypub"
 	"github.com/marcusziade/jewornotjew/pkg/db"
+	"github.com/marcusziade/jewornotjew/pkg/models"
 )
 
-// Server represents the API server
+// Defaults for the FTS5 search mode's limit/offset pagination.
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 200
+)
+
+// Server represents the API server. It started as a read-only demo
+// frontend for pkg/db; Option now lets operators turn on the things a
+// deployment behind a reverse proxy needs: CORS, per-IP rate limiting, and
+// bearer-token auth for the write endpoints.
 type Server struct {
-	db     *db.DB
-	router *mux.Router
+	db            db.Store
+	router        *mux.Router
+	metrics       *serverMetrics
+	authToken     string
+	corsOrigins   []string
+	rateLimiter   *ipRateLimiter
+	graphqlSchema graphql.Schema
+	baseURL       string
+	apSigner      *activitypub.Signer
+}
+
+// Option configures a Server. See WithAuthToken, WithCORSOrigins,
+// WithRateLimit, WithBaseURL, and WithActivityPubKey.
+type Option func(*Server)
+
+// WithAuthToken gates the write endpoints (POST/PUT/DELETE /api/profiles)
+// behind "Authorization: Bearer <token>". Without this option the write
+// path is open, which is only appropriate in local development or behind
+// a trusted proxy.
+func WithAuthToken(token string) Option {
+	return func(s *Server) { s.authToken = token }
+}
+
+// WithCORSOrigins allows cross-origin requests from the given origins.
+// "*" allows any origin. Without this option no CORS headers are sent.
+func WithCORSOrigins(origins ...string) Option {
+	return func(s *Server) { s.corsOrigins = origins }
 }
 
-// NewServer creates a new API server
-func NewServer(db *db.DB) *Server {
+// WithRateLimit limits each client IP to requestsPerSecond sustained
+// requests, allowing bursts up to burst. Without this option requests are
+// not rate limited.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(s *Server) { s.rateLimiter = newIPRateLimiter(requestsPerSecond, burst) }
+}
+
+// WithBaseURL sets the externally reachable base URL (e.g.
+// "https://jewornotjew.example") used to build absolute ids and links in
+// the ActivityPub actor, outbox, and WebFinger responses. Without this
+// option those ids are root-relative paths, which is fine for local
+// testing but not for federation with real ActivityPub servers.
+func WithBaseURL(baseURL string) Option {
+	return func(s *Server) { s.baseURL = strings.TrimSuffix(baseURL, "/") }
+}
+
+// WithActivityPubKey loads an RSA private key from keyPath and signs every
+// ActivityPub response with it, so Mastodon-compatible servers can verify
+// the actor/outbox/WebFinger responses they dereference came from this
+// server. Without this option those responses are served unsigned.
+func WithActivityPubKey(keyPath string) Option {
+	return func(s *Server) {
+		signer, err := activitypub.NewSigner(keyPath)
+		if err != nil {
+			log.Printf("Warning: -ap-key-path requested but the key could not be loaded, serving ActivityPub responses unsigned: %v", err)
+			return
+		}
+		s.apSigner = signer
+	}
+}
+
+// NewServer creates a new API server backed by db, applying any opts.
+func NewServer(db db.Store, opts ...Option) *Server {
+	schema, err := newGraphQLSchema(db)
+	if err != nil {
+		// The schema is built entirely from the fixed types above, so a
+		// failure here means one of them is wired up wrong, not anything
+		// caller-dependent - same class of bug as adapter's embedded
+		// default config, which panics for the same reason.
+		panic(fmt.Sprintf("invalid GraphQL schema: %v", err))
+	}
+
 	s := &Server{
-		db:     db,
-		router: mux.NewRouter(),
+		db:            db,
+		router:        mux.NewRouter(),
+		metrics:       newServerMetrics(),
+		graphqlSchema: schema,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
 	s.routes()
 	return s
 }
 
-// routes sets up the routes for the API server
+// routes sets up the routes for the API server. Every route except
+// /graphql/ui, /healthz, and /metrics goes through the same middleware chain
+// (logging, metrics, gzip, CORS, rate limiting); the write endpoints
+// additionally require auth.
 func (s *Server) routes() {
-	s.router.HandleFunc("/api/profiles", s.listProfiles).Methods("GET")
-	s.router.HandleFunc("/api/profiles/{name}", s.getProfile).Methods("GET")
-	s.router.HandleFunc("/api/search", s.searchProfiles).Methods("GET")
+	common := []middleware{withLogging, s.withMetrics, withGzip, withCORS(s.corsOrigins), s.withRateLimit}
+
+	get := func(path string, h http.HandlerFunc) {
+		s.router.HandleFunc(path, chain(h, common...)).Methods("GET", "OPTIONS")
+	}
+	write := func(path string, h http.HandlerFunc, methods ...string) {
+		mws := append(append([]middleware{}, common...), s.withAuth)
+		s.router.HandleFunc(path, chain(h, mws...)).Methods(append(methods, "OPTIONS")...)
+	}
+
+	get("/api/profiles", s.listProfiles)
+	get("/api/profiles/{name}", s.getProfile)
+	get("/api/search", s.searchProfiles)
+	get("/api/stats", s.cacheStats)
+	get("/api/feed.rss", s.feedAll("rss"))
+	get("/api/feed.atom", s.feedAll("atom"))
+	get("/api/category/{name}/feed.rss", s.feedByCategory("rss"))
+	get("/api/category/{name}/feed.atom", s.feedByCategory("atom"))
+	get("/ap/actor/{name}", s.apActor)
+	get("/ap/outbox/{name}", s.apOutbox)
+	get("/.well-known/webfinger", s.webfinger)
+	get("/profiles/{name}", s.profileHCard)
+	get("/api/v1/accounts/lookup", s.mastoAccountLookup)
+	get("/api/v1/accounts/search", s.mastoAccountSearch)
+	get("/api/v1/accounts/{id}", s.mastoAccountByID)
+	write("/api/profiles", s.createProfile, "POST")
+	write("/api/profiles/{name}", s.updateProfile, "PUT")
+	write("/api/profiles/{name}", s.deleteProfile, "DELETE")
+	s.router.HandleFunc("/webmention", chain(s.handleWebmention, common...)).Methods("POST", "OPTIONS")
+
+	s.router.HandleFunc("/graphql", chain(s.handleGraphQL, common...)).Methods("POST", "OPTIONS")
+	s.router.HandleFunc("/graphql/ui", s.handleGraphQLUI).Methods("GET")
+
+	s.router.HandleFunc("/healthz", s.healthz).Methods("GET")
+	s.router.Handle("/metrics", s.metrics.Handler()).Methods("GET")
 }
 
 // ServeHTTP implements the http.Handler interface
@@ -45,13 +164,35 @@ func (s *Server) ListenAndServe(addr string) error {
 	return http.ListenAndServe(addr, s)
 }
 
+// healthz is a liveness probe: it reports ok as soon as the process can
+// serve HTTP, without touching the database.
+func (s *Server) healthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	fmt.Fprintln(w, "ok")
+}
+
+// timeQuery observes how long fn takes in s's dbQueryDuration histogram,
+// labeled by method, and returns fn's error.
+func (s *Server) timeQuery(method string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	s.metrics.dbQueryDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	return err
+}
+
 // listProfiles handles GET /api/profiles
 func (s *Server) listProfiles(w http.ResponseWriter, r *http.Request) {
-	profiles, err := s.db.ListProfiles()
+	var profiles []*models.Profile
+	err := s.timeQuery("ListProfiles", func() error {
+		var err error
+		profiles, err = s.db.ListProfiles()
+		return err
+	})
 	if err != nil {
 		http.Error(w, fmt.Sprintf("Failed to list profiles: %v", err), http.StatusInternalServerError)
 		return
 	}
+	s.metrics.profilesTotal.Set(float64(len(profiles)))
 
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(profiles); err != nil {
@@ -62,10 +203,14 @@ func (s *Server) listProfiles(w http.ResponseWriter, r *http.Request) {
 
 // getProfile handles GET /api/profiles/{name}
 func (s *Server) getProfile(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	name := vars["name"]
+	name := mux.Vars(r)["name"]
 
-	profile, err := s.db.GetProfile(name)
+	var profile *models.Profile
+	err := s.timeQuery("GetProfile", func() error {
+		var err error
+		profile, err = s.db.GetProfile(name)
+		return err
+	})
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			http.Error(w, fmt.Sprintf("Profile not found: %s", name), http.StatusNotFound)
@@ -82,7 +227,87 @@ func (s *Server) getProfile(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// searchProfiles handles GET /api/search?q=query
+// createProfile handles POST /api/profiles. The body is a JSON-encoded
+// models.Profile; since db.Store.InsertProfile upserts by name, this also
+// updates an existing profile of the same name.
+func (s *Server) createProfile(w http.ResponseWriter, r *http.Request) {
+	var profile models.Profile
+	if err := json.NewDecoder(r.Body).Decode(&profile); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+	if profile.Name == "" {
+		http.Error(w, "Profile name is required", http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	if profile.CreatedAt == "" {
+		profile.CreatedAt = now
+	}
+	profile.UpdatedAt = now
+
+	err := s.timeQuery("InsertProfile", func() error { return s.db.InsertProfile(&profile) })
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to create profile: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(&profile); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to encode response: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+// updateProfile handles PUT /api/profiles/{name}. The URL's {name}
+// overrides any name in the body, so a client can't rename a profile
+// through this endpoint by mistake.
+func (s *Server) updateProfile(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	var profile models.Profile
+	if err := json.NewDecoder(r.Body).Decode(&profile); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+	profile.Name = name
+	profile.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	err := s.timeQuery("InsertProfile", func() error { return s.db.InsertProfile(&profile) })
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to update profile: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(&profile); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to encode response: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+// deleteProfile handles DELETE /api/profiles/{name}
+func (s *Server) deleteProfile(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	err := s.timeQuery("DeleteProfile", func() error { return s.db.DeleteProfile(name) })
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, fmt.Sprintf("Profile not found: %s", name), http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("Failed to delete profile: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// searchProfiles handles GET /api/search?q=query. With mode=fts it ranks
+// results using the fts_profiles FTS5 index instead of the plain LIKE scan,
+// accepting FTS5 MATCH syntax in q and limit/offset for pagination.
 func (s *Server) searchProfiles(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query().Get("q")
 	if query == "" {
@@ -90,7 +315,17 @@ func (s *Server) searchProfiles(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	profiles, err := s.db.SearchProfiles(query)
+	if r.URL.Query().Get("mode") == "fts" {
+		s.searchProfilesFTS(w, r, query)
+		return
+	}
+
+	var profiles []*models.Profile
+	err := s.timeQuery("SearchProfiles", func() error {
+		var err error
+		profiles, err = s.db.SearchProfiles(query)
+		return err
+	})
 	if err != nil {
 		http.Error(w, fmt.Sprintf("Failed to search profiles: %v", err), http.StatusInternalServerError)
 		return
@@ -101,4 +336,69 @@ func (s *Server) searchProfiles(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, fmt.Sprintf("Failed to encode response: %v", err), http.StatusInternalServerError)
 		return
 	}
-}
\ No newline at end of file
+}
+
+// searchProfilesFTS handles the mode=fts branch of /api/search.
+func (s *Server) searchProfilesFTS(w http.ResponseWriter, r *http.Request, query string) {
+	limit := defaultSearchLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			http.Error(w, "Query parameter 'limit' must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		if n > maxSearchLimit {
+			n = maxSearchLimit
+		}
+		limit = n
+	}
+
+	offset := 0
+	if v := r.URL.Query().Get("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			http.Error(w, "Query parameter 'offset' must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+		offset = n
+	}
+
+	var results []*db.FTSSearchResult
+	err := s.timeQuery("SearchProfilesFTS", func() error {
+		var err error
+		results, err = s.db.SearchProfilesFTS(query, limit, offset)
+		return err
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to search profiles: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to encode response: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+// cacheStats handles GET /api/stats, reporting Redis cache effectiveness
+// when s.db was built with db.NewCachedStore. Without caching enabled it
+// reports that explicitly rather than 404ing, since "no cache" is a valid
+// state operators will want to see confirmed.
+func (s *Server) cacheStats(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	cacher, ok := s.db.(db.CacheStatser)
+	if !ok {
+		json.NewEncoder(w).Encode(map[string]interface{}{"cache_enabled": false})
+		return
+	}
+
+	stats, err := cacher.CacheStats()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to fetch cache stats: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{"cache_enabled": true, "stats": stats})
+}
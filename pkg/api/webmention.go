@@ -0,0 +1,182 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/marcusziade/jewornotjew/pkg/webmention"
+)
+
+// maxWebmentionSourceBytes caps how much of a source page this server
+// reads when discovering its microformats2 markup.
+const maxWebmentionSourceBytes = 5 << 20 // 5MB
+
+// maxWebmentionRedirects caps how many redirects a source fetch follows,
+// so a malicious source can't chain redirects indefinitely.
+const maxWebmentionRedirects = 5
+
+// errNonPublicAddress is returned by webmentionDialer's Control hook when a
+// source (or a redirect it issues) resolves to a loopback, private,
+// link-local, or otherwise non-public address.
+var errNonPublicAddress = errors.New("refusing to connect to a non-public address")
+
+// webmentionDialer refuses to connect to any address that isn't publicly
+// routable. /webmention is public and unauthenticated, and it fetches
+// whatever URL a caller supplies as "source" - without this, it's a
+// ready-made SSRF proxy into the deployment's internal network (cloud
+// metadata endpoints, localhost services, RFC1918 ranges). Control runs
+// against the address actually being dialed, after DNS resolution, so this
+// also closes the DNS-rebinding gap a check-then-connect approach on the
+// hostname would leave open.
+var webmentionDialer = &net.Dialer{
+	Timeout: 10 * time.Second,
+	Control: func(network, address string, c syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		ip := net.ParseIP(host)
+		if ip == nil || !isPublicAddress(ip) {
+			return errNonPublicAddress
+		}
+		return nil
+	},
+}
+
+// isPublicAddress reports whether ip is safe for this server to connect
+// to on a caller's behalf.
+func isPublicAddress(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsUnspecified() &&
+		!ip.IsMulticast()
+}
+
+// checkWebmentionRedirect caps redirect chains and restricts every hop to
+// http(s); webmentionDialer's Control hook still blocks any hop that
+// resolves to a non-public address.
+func checkWebmentionRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxWebmentionRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxWebmentionRedirects)
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return fmt.Errorf("unsupported redirect scheme: %s", req.URL.Scheme)
+	}
+	return nil
+}
+
+// webmentionHTTPClient fetches webmention sources directly (not through
+// c.politeness, which paces the scraper's own crawl of jewornotjew.com):
+// these are one-off fetches of whatever page a third party just told us
+// links here, so its transport dials through webmentionDialer rather than
+// the default one.
+var webmentionHTTPClient = &http.Client{
+	Timeout:       10 * time.Second,
+	Transport:     &http.Transport{DialContext: webmentionDialer.DialContext},
+	CheckRedirect: checkWebmentionRedirect,
+}
+
+// handleWebmention handles POST /webmention: a source page claims to
+// reference one of this server's profile pages (the target). Per the
+// Webmention spec, the target must resolve to a page this server serves,
+// and the source must actually link to it - so the source is fetched and
+// checked before anything is stored.
+func (s *Server) handleWebmention(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form body", http.StatusBadRequest)
+		return
+	}
+	sourceURL := r.PostForm.Get("source")
+	targetURL := r.PostForm.Get("target")
+	if sourceURL == "" || targetURL == "" {
+		http.Error(w, "Both 'source' and 'target' parameters are required", http.StatusBadRequest)
+		return
+	}
+
+	name, ok := profileNameFromTarget(targetURL)
+	if !ok {
+		http.Error(w, "Target does not resolve to a profile page", http.StatusBadRequest)
+		return
+	}
+	if _, err := s.db.GetProfile(name); err != nil {
+		http.Error(w, "Target does not resolve to a profile page", http.StatusBadRequest)
+		return
+	}
+
+	base, err := url.Parse(sourceURL)
+	if err != nil {
+		http.Error(w, "Invalid source URL", http.StatusBadRequest)
+		return
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		http.Error(w, "Source must be an http(s) URL", http.StatusBadRequest)
+		return
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, sourceURL, nil)
+	if err != nil {
+		http.Error(w, "Invalid source URL", http.StatusBadRequest)
+		return
+	}
+	resp, err := webmentionHTTPClient.Do(req)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to fetch source: %v", err), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		http.Error(w, fmt.Sprintf("Source returned non-200 response: %d", resp.StatusCode), http.StatusBadGateway)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxWebmentionSourceBytes))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to read source: %v", err), http.StatusBadGateway)
+		return
+	}
+	if !strings.Contains(string(body), targetURL) {
+		http.Error(w, "Source does not link to target", http.StatusBadRequest)
+		return
+	}
+
+	wm, err := webmention.ParseEntry(strings.NewReader(string(body)), sourceURL, base, targetURL)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to parse source: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := s.db.InsertWebmention(name, wm); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to store webmention: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// profileNameFromTarget extracts the {name} from a target URL pointing
+// at /profiles/{name}, ignoring scheme and host so it works whether
+// target was given as absolute or root-relative.
+func profileNameFromTarget(target string) (string, bool) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return "", false
+	}
+	const prefix = "/profiles/"
+	if !strings.HasPrefix(u.Path, prefix) {
+		return "", false
+	}
+	name, err := url.PathUnescape(strings.TrimPrefix(u.Path, prefix))
+	if err != nil || name == "" {
+		return "", false
+	}
+	return name, true
+}
@@ -0,0 +1,197 @@
+// Package assets downloads and locally caches the images referenced by
+// Profile.ImageURL: it fetches the image, sniffs its real content type with
+// http.DetectContentType (rejecting anything that isn't an image regardless
+// of what the URL or server claims), stores the original under
+// "<dataDir>/images/<sha256>.<ext>", and generates small/large JPEG
+// thumbnails alongside it. This keeps profile rendering off the source
+// site's image hosting entirely.
+package assets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/image/draw"
+
+	"github.com/marcusziade/jewornotjew/pkg/politeness"
+)
+
+// imagesDir is the subdirectory of a client's dataDir that originals and
+// thumbnails are stored under.
+const imagesDir = "images"
+
+// ThumbnailSizes are the square thumbnail widths/heights generated for
+// every downloaded image, keyed into Result.Thumbnails (and
+// models.Profile.Thumbnails) as "128"/"512".
+var ThumbnailSizes = []int{128, 512}
+
+// Result is what Fetch returns: everything needed to populate a
+// models.Profile's Image* fields.
+type Result struct {
+	LocalPath  string
+	MIME       string
+	SHA256     string
+	Thumbnails map[string]string // size (e.g. "128") -> thumbnail path
+}
+
+// Fetch downloads imageURL with client, paced and retried by policy the
+// same way a profile scrape is, sniffs its content type, and — if it's an
+// image — saves the original and generated thumbnails under
+// "<dataDir>/images". Non-image payloads are rejected with an error rather
+// than cached.
+//
+// Thumbnail generation is best-effort: if Go's standard image codecs can't
+// decode the downloaded bytes (most commonly a WebP original, which isn't
+// supported by image.Decode without a dedicated decoder), Fetch still
+// returns successfully with the original saved and Thumbnails left nil.
+func Fetch(ctx context.Context, client *http.Client, policy *politeness.Policy, dataDir, imageURL string) (*Result, error) {
+	if allowed, err := policy.Allowed(ctx, client, imageURL); err == nil && !allowed {
+		return nil, fmt.Errorf("robots.txt disallows %s", imageURL)
+	}
+	if err := policy.Wait(ctx, imageURL); err != nil {
+		return nil, fmt.Errorf("rate limiter wait failed: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := policy.Do(ctx, client, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received non-200 response: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image body: %w", err)
+	}
+
+	result, err := saveBytes(dataDir, body)
+	if err != nil {
+		return nil, fmt.Errorf("%w (from %s)", err, imageURL)
+	}
+	return result, nil
+}
+
+// Save reads an image (e.g. an uploaded multipart file) from r and, like
+// Fetch, sniffs its content type, rejects non-image payloads, and stores
+// the original plus generated thumbnails under "<dataDir>/images". Unlike
+// Fetch, it doesn't touch the network: this is the entry point for
+// user-submitted images rather than ones scraped from a source site.
+func Save(dataDir string, r io.Reader) (*Result, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image body: %w", err)
+	}
+
+	return saveBytes(dataDir, body)
+}
+
+// saveBytes sniffs body's content type and, if it's an image, stores the
+// original and generated thumbnails under "<dataDir>/images".
+func saveBytes(dataDir string, body []byte) (*Result, error) {
+	mime := http.DetectContentType(body)
+	if !strings.HasPrefix(mime, "image/") {
+		return nil, fmt.Errorf("rejected non-image content type %q", mime)
+	}
+
+	sum := sha256.Sum256(body)
+	hash := hex.EncodeToString(sum[:])
+
+	imagesPath := filepath.Join(dataDir, imagesDir)
+	if err := os.MkdirAll(imagesPath, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create images directory: %w", err)
+	}
+
+	originalPath := filepath.Join(imagesPath, hash+extensionForMIME(mime))
+	if err := os.WriteFile(originalPath, body, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write original image: %w", err)
+	}
+
+	result := &Result{LocalPath: originalPath, MIME: mime, SHA256: hash}
+
+	img, _, err := image.Decode(bytes.NewReader(body))
+	if err != nil {
+		return result, nil
+	}
+
+	thumbnails := make(map[string]string, len(ThumbnailSizes))
+	for _, size := range ThumbnailSizes {
+		thumbPath := filepath.Join(imagesPath, fmt.Sprintf("%s-%d.jpg", hash, size))
+		if err := writeThumbnail(img, size, thumbPath); err != nil {
+			return nil, fmt.Errorf("failed to generate %dpx thumbnail: %w", size, err)
+		}
+		thumbnails[fmt.Sprintf("%d", size)] = thumbPath
+	}
+	result.Thumbnails = thumbnails
+
+	return result, nil
+}
+
+// writeThumbnail resizes img to a size x size square with x/image/draw's
+// CatmullRom scaler and writes it as a JPEG to path. img is center-cropped
+// to a square first (see centerSquare) so non-square sources are cropped
+// rather than stretched into the square thumbnail.
+func writeThumbnail(img image.Image, size int, path string) error {
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, centerSquare(img.Bounds()), draw.Over, nil)
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create thumbnail file: %w", err)
+	}
+	defer f.Close()
+
+	if err := jpeg.Encode(f, dst, &jpeg.Options{Quality: 85}); err != nil {
+		return fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+	return nil
+}
+
+// centerSquare returns the largest square centered within b, so scaling
+// from it to a size x size destination crops to center instead of
+// stretching a non-square image's aspect ratio.
+func centerSquare(b image.Rectangle) image.Rectangle {
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+	return image.Rect(x0, y0, x0+side, y0+side)
+}
+
+// extensionForMIME returns the file extension (with leading dot) to store
+// an image under for a sniffed MIME type, or "" if it's unrecognized.
+func extensionForMIME(mime string) string {
+	switch mime {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,225 @@
+// Package classify infers a profile's Category with a multinomial Naive
+// Bayes classifier trained on already-labeled profiles, rather than
+// matching the first keyword that happens to hit — the kind of heuristic
+// that miscategorizes, say, an author who also played baseball. A
+// Classifier is trained once (see Train, called from Client.LoadFromDisk)
+// and then scores unlabeled profiles by tokenizing their Name/Description/
+// Pros/Cons into word unigrams and bigrams and computing
+// argmax_c log P(c) + Σ log P(t|c), with Laplace smoothing over the
+// trained vocabulary. The winning category's confidence is a softmax over
+// the per-category log-likelihoods; below Threshold, Categorize falls back
+// to a small keyword heuristic instead of trusting a low-confidence guess.
+package classify
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// defaultThreshold is the confidence below which Categorize prefers the
+// keyword fallback over the classifier's own top pick.
+const defaultThreshold = 0.6
+
+// tokenRe splits profile text into lowercase word tokens.
+var tokenRe = regexp.MustCompile(`[a-z0-9]+`)
+
+// Classifier is a multinomial Naive Bayes category classifier. The zero
+// value is not usable; construct with New or Train.
+type Classifier struct {
+	classPriors map[string]float64       // category -> log P(category)
+	tokenCounts map[string]map[string]int // category -> token -> count
+	totalTokens map[string]int            // category -> sum of tokenCounts[category]
+	vocab       map[string]struct{}
+	categories  []string // sorted, for deterministic argmax tie-breaking
+	threshold   float64
+}
+
+// New returns an untrained Classifier: Classify/Categorize on it always
+// fall through to the keyword heuristic.
+func New() *Classifier {
+	return &Classifier{
+		classPriors: make(map[string]float64),
+		tokenCounts: make(map[string]map[string]int),
+		totalTokens: make(map[string]int),
+		vocab:       make(map[string]struct{}),
+		threshold:   defaultThreshold,
+	}
+}
+
+// Train builds a Classifier from profiles that already have a Category
+// set; unlabeled profiles are skipped as training data (they're exactly
+// what Categorize is later used to fill in).
+func Train(profiles []*models.Profile) *Classifier {
+	c := New()
+
+	docsByCategory := make(map[string]int)
+	totalDocs := 0
+
+	for _, p := range profiles {
+		if p == nil || p.Category == "" {
+			continue
+		}
+		totalDocs++
+		docsByCategory[p.Category]++
+
+		if c.tokenCounts[p.Category] == nil {
+			c.tokenCounts[p.Category] = make(map[string]int)
+		}
+		for _, token := range tokensFor(p) {
+			c.tokenCounts[p.Category][token]++
+			c.totalTokens[p.Category]++
+			c.vocab[token] = struct{}{}
+		}
+	}
+
+	for category, count := range docsByCategory {
+		c.classPriors[category] = math.Log(float64(count) / float64(totalDocs))
+		c.categories = append(c.categories, category)
+	}
+	sort.Strings(c.categories)
+
+	return c
+}
+
+// Threshold returns the confidence below which Categorize prefers the
+// keyword fallback over the classifier's own top pick.
+func (c *Classifier) Threshold() float64 {
+	return c.threshold
+}
+
+// Classify returns the classifier's top-scoring category for profile and
+// its confidence (a softmax over the per-category log-likelihoods, so it
+// sums to 1 across categories). It returns ("", 0) if the classifier has
+// no training data at all, or if profile shares no token with the trained
+// vocabulary: with nothing but Laplace-smoothed noise to score, each
+// category's estimate is really just a function of how much training text
+// it happened to have, not of profile, so the confident-looking winner
+// that falls out of softmax would be spurious.
+func (c *Classifier) Classify(profile *models.Profile) (string, float64) {
+	if len(c.categories) == 0 {
+		return "", 0
+	}
+
+	tokens := tokensFor(profile)
+	if !c.hasKnownToken(tokens) {
+		return "", 0
+	}
+	vocabSize := len(c.vocab)
+
+	scores := make(map[string]float64, len(c.categories))
+	for _, category := range c.categories {
+		score := c.classPriors[category]
+		total := c.totalTokens[category]
+		counts := c.tokenCounts[category]
+		for _, token := range tokens {
+			// Laplace (add-one) smoothing so an unseen token doesn't zero
+			// out the whole category.
+			score += math.Log(float64(counts[token]+1) / float64(total+vocabSize))
+		}
+		scores[category] = score
+	}
+
+	best := c.categories[0]
+	for _, category := range c.categories {
+		if scores[category] > scores[best] {
+			best = category
+		}
+	}
+
+	return best, softmaxConfidence(scores, best)
+}
+
+// Categorize returns the classifier's pick for profile if its confidence
+// clears Threshold, otherwise the keyword fallback's pick (with the
+// classifier's low confidence still reported, since the fallback itself
+// carries none).
+func (c *Classifier) Categorize(profile *models.Profile) (string, float64) {
+	category, confidence := c.Classify(profile)
+	if confidence >= c.threshold {
+		return category, confidence
+	}
+	if fallback := keywordFallback(profile); fallback != "" {
+		return fallback, confidence
+	}
+	return category, confidence
+}
+
+// hasKnownToken reports whether any of tokens was seen anywhere in
+// training, i.e. whether there's any real evidence to classify on at all.
+func (c *Classifier) hasKnownToken(tokens []string) bool {
+	for _, token := range tokens {
+		if _, ok := c.vocab[token]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// softmaxConfidence normalizes scores (log-likelihoods) into a probability
+// for winner via softmax, shifted by the max score for numerical stability.
+func softmaxConfidence(scores map[string]float64, winner string) float64 {
+	maxScore := math.Inf(-1)
+	for _, score := range scores {
+		if score > maxScore {
+			maxScore = score
+		}
+	}
+
+	var sum float64
+	for _, score := range scores {
+		sum += math.Exp(score - maxScore)
+	}
+	if sum == 0 {
+		return 0
+	}
+	return math.Exp(scores[winner]-maxScore) / sum
+}
+
+// tokensFor tokenizes profile's Name/Description/Pros/Cons into lowercase
+// word unigrams plus adjacent-pair bigrams ("word1_word2").
+func tokensFor(p *models.Profile) []string {
+	text := strings.Join([]string{p.Name, p.Description, strings.Join(p.Pros, " "), strings.Join(p.Cons, " ")}, " ")
+	words := tokenRe.FindAllString(strings.ToLower(text), -1)
+
+	tokens := make([]string, 0, len(words)*2)
+	tokens = append(tokens, words...)
+	for i := 0; i+1 < len(words); i++ {
+		tokens = append(tokens, words[i]+"_"+words[i+1])
+	}
+	return tokens
+}
+
+// keywordRules is the hand-coded fallback Categorize uses when the
+// classifier's confidence is too low to trust — an undertrained category,
+// or a profile whose description mixes signals from several categories.
+// Checked in order; the first keyword match wins.
+var keywordRules = []struct {
+	Category string
+	Keywords []string
+}{
+	{"Music", []string{"singer", "musician", "band", "album", "songwriter", "composer"}},
+	{"Film & TV", []string{"actor", "actress", "director", "film", "television", "movie"}},
+	{"Sports", []string{"athlete", "baseball", "basketball", "football", "olympic"}},
+	{"Literature", []string{"author", "novelist", "poet", "journalist"}},
+	{"Science", []string{"scientist", "physicist", "biologist", "researcher", "professor"}},
+	{"Politics", []string{"senator", "president", "politician", "governor", "diplomat"}},
+	{"Business", []string{"entrepreneur", "ceo", "founder", "businessman", "businesswoman"}},
+}
+
+// keywordFallback returns the first keyword-matched category for profile,
+// or "" if none hit.
+func keywordFallback(profile *models.Profile) string {
+	text := strings.ToLower(profile.Name + " " + profile.Description)
+	for _, rule := range keywordRules {
+		for _, keyword := range rule.Keywords {
+			if strings.Contains(text, keyword) {
+				return rule.Category
+			}
+		}
+	}
+	return ""
+}
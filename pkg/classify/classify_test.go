@@ -0,0 +1,132 @@
+package classify
+
+import (
+	"testing"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+func trainingProfiles() []*models.Profile {
+	return []*models.Profile{
+		{Name: "Albert Einstein", Description: "theoretical physicist who developed relativity", Category: "Science"},
+		{Name: "Marie Curie", Description: "physicist and chemist who researched radioactivity", Category: "Science"},
+		{Name: "Richard Feynman", Description: "physicist known for quantum electrodynamics research", Category: "Science"},
+		{Name: "Adam Sandler", Description: "actor and comedian known for film comedies", Category: "Film & TV"},
+		{Name: "Steven Spielberg", Description: "film director and producer of Hollywood movies", Category: "Film & TV"},
+		{Name: "Natalie Portman", Description: "actress known for film roles and movies", Category: "Film & TV"},
+		{Name: "", Description: "", Category: ""}, // unlabeled, must be skipped as training data
+	}
+}
+
+func TestNewReturnsUntrainedClassifier(t *testing.T) {
+	c := New()
+	category, confidence := c.Classify(&models.Profile{Name: "Anyone", Description: "anything"})
+	if category != "" || confidence != 0 {
+		t.Errorf("Classify() on an untrained Classifier = (%q, %v), want (\"\", 0)", category, confidence)
+	}
+}
+
+func TestTrainSkipsUnlabeledProfiles(t *testing.T) {
+	c := Train(trainingProfiles())
+	for _, category := range c.categories {
+		if category == "" {
+			t.Error("Train() included an empty category from an unlabeled profile")
+		}
+	}
+}
+
+func TestClassifyPicksTrainedCategory(t *testing.T) {
+	c := Train(trainingProfiles())
+
+	tests := []struct {
+		name    string
+		profile *models.Profile
+		want    string
+	}{
+		{
+			"clearly a physicist",
+			&models.Profile{Name: "Niels Bohr", Description: "physicist who researched quantum theory"},
+			"Science",
+		},
+		{
+			"clearly an actor",
+			&models.Profile{Name: "Tom Hanks", Description: "actor known for many film and movie roles"},
+			"Film & TV",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, confidence := c.Classify(tt.profile)
+			if got != tt.want {
+				t.Errorf("Classify() category = %q, want %q", got, tt.want)
+			}
+			if confidence <= 0 || confidence > 1 {
+				t.Errorf("Classify() confidence = %v, want a value in (0, 1]", confidence)
+			}
+		})
+	}
+}
+
+func TestCategorizeFallsBackBelowThreshold(t *testing.T) {
+	c := Train(trainingProfiles())
+
+	// A profile whose text gives the classifier almost nothing to go on, but
+	// whose description contains a keyword the hand-coded fallback rules do
+	// recognize.
+	profile := &models.Profile{Name: "X", Description: "a baseball athlete"}
+
+	category, _ := c.Categorize(profile)
+	if category != "Sports" {
+		t.Errorf("Categorize() = %q, want the keyword fallback's Sports", category)
+	}
+}
+
+func TestCategorizeTrustsHighConfidenceClassifierPick(t *testing.T) {
+	c := Train(trainingProfiles())
+
+	profile := &models.Profile{Name: "Enrico Fermi", Description: "physicist physicist physicist researcher researcher"}
+	category, confidence := c.Categorize(profile)
+	if category != "Science" {
+		t.Errorf("Categorize() = %q, want Science", category)
+	}
+	if confidence < c.Threshold() {
+		t.Errorf("Categorize() confidence = %v, want >= Threshold() (%v) for a lopsided match", confidence, c.Threshold())
+	}
+}
+
+func TestKeywordFallback(t *testing.T) {
+	tests := []struct {
+		name, text, want string
+	}{
+		{"music", "a famous singer and songwriter", "Music"},
+		{"film", "an award-winning actress", "Film & TV"},
+		{"sports", "an olympic athlete", "Sports"},
+		{"literature", "a celebrated novelist", "Literature"},
+		{"science", "a noted biologist", "Science"},
+		{"politics", "a state senator", "Politics"},
+		{"business", "a startup entrepreneur", "Business"},
+		{"no match", "a person who does many things", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keywordFallback(&models.Profile{Description: tt.text}); got != tt.want {
+				t.Errorf("keywordFallback(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokensForIncludesUnigramsAndBigrams(t *testing.T) {
+	tokens := tokensFor(&models.Profile{Name: "Jane Doe", Description: "a writer"})
+
+	want := map[string]bool{"jane": true, "doe": true, "a": true, "writer": true, "jane_doe": true}
+	got := make(map[string]bool, len(tokens))
+	for _, tok := range tokens {
+		got[tok] = true
+	}
+	for tok := range want {
+		if !got[tok] {
+			t.Errorf("tokensFor() missing expected token %q, got %v", tok, tokens)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// CacheConfig configures the on-disk HTTP cache a Client consults before
+// re-fetching a URL it has already scraped. It's loaded from a
+// config.json alongside the usual CLI flags (see LoadCacheConfig),
+// matching the {enabled, path, lifetime, max_size} shape other scraper
+// config lives in.
+type CacheConfig struct {
+	Enabled bool `json:"enabled"`
+	// Path is the cache directory; created on first use if missing.
+	Path string `json:"path"`
+	// Lifetime is how long a cached response is served without
+	// re-fetching, as a time.ParseDuration string (e.g. "24h").
+	Lifetime string `json:"lifetime"`
+	// MaxSize is the cache's on-disk byte budget; once exceeded, the
+	// least-recently-used entries are evicted until it's back under budget.
+	MaxSize int64 `json:"max_size"`
+}
+
+// LoadCacheConfig reads a CacheConfig from a JSON file at path.
+func LoadCacheConfig(path string) (CacheConfig, error) {
+	var cfg CacheConfig
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read cache config %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse cache config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// httpCache is an LRU-evicted, TTL-expiring on-disk cache of HTTP response
+// bodies, keyed by request URL. Each entry is a body file plus a sidecar
+// metadata file (fetchedAt, contentHash) under path/<sha1(url)>.
+type httpCache struct {
+	path     string
+	lifetime time.Duration
+	maxSize  int64
+}
+
+// cacheMeta is the sidecar JSON stored next to each cached body.
+type cacheMeta struct {
+	FetchedAt   time.Time `json:"fetched_at"`
+	ContentHash string    `json:"content_hash"`
+}
+
+// newHTTPCache creates the cache directory if needed and returns an
+// httpCache rooted at cfg.Path.
+func newHTTPCache(cfg CacheConfig) (*httpCache, error) {
+	lifetime, err := time.ParseDuration(cfg.Lifetime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cache lifetime %q: %w", cfg.Lifetime, err)
+	}
+	if err := os.MkdirAll(cfg.Path, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory %s: %w", cfg.Path, err)
+	}
+	return &httpCache{path: cfg.Path, lifetime: lifetime, maxSize: cfg.MaxSize}, nil
+}
+
+// cacheKey hashes rawURL with SHA-1 (this is a filename, not a security
+// boundary) so arbitrary query strings map to a safe, fixed-length path.
+func cacheKey(rawURL string) string {
+	sum := sha1.Sum([]byte(rawURL))
+	return hex.EncodeToString(sum[:])
+}
+
+func (h *httpCache) bodyPath(key string) string { return filepath.Join(h.path, key) }
+func (h *httpCache) metaPath(key string) string { return filepath.Join(h.path, key+".meta.json") }
+
+// get returns the cached body for rawURL if an entry exists and is younger
+// than h.lifetime, touching its access time for LRU purposes. refresh, if
+// true, treats every entry as stale so the caller always re-fetches (but
+// the stale entry is still overwritten by the caller's subsequent put).
+func (h *httpCache) get(rawURL string, refresh bool) ([]byte, bool) {
+	key := cacheKey(rawURL)
+
+	metaData, err := os.ReadFile(h.metaPath(key))
+	if err != nil {
+		return nil, false
+	}
+	var meta cacheMeta
+	if err := json.Unmarshal(metaData, &meta); err != nil {
+		return nil, false
+	}
+	if refresh || time.Since(meta.FetchedAt) >= h.lifetime {
+		return nil, false
+	}
+
+	body, err := os.ReadFile(h.bodyPath(key))
+	if err != nil {
+		return nil, false
+	}
+
+	now := time.Now()
+	os.Chtimes(h.bodyPath(key), now, now)
+	os.Chtimes(h.metaPath(key), now, now)
+
+	return body, true
+}
+
+// put stores body for rawURL and evicts least-recently-used entries until
+// the cache is back under h.maxSize.
+func (h *httpCache) put(rawURL string, body []byte) error {
+	key := cacheKey(rawURL)
+
+	if err := os.WriteFile(h.bodyPath(key), body, 0644); err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+
+	sum := sha256.Sum256(body)
+	meta := cacheMeta{FetchedAt: time.Now(), ContentHash: hex.EncodeToString(sum[:])}
+	metaData, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache metadata: %w", err)
+	}
+	if err := os.WriteFile(h.metaPath(key), metaData, 0644); err != nil {
+		return fmt.Errorf("failed to write cache metadata: %w", err)
+	}
+
+	return h.evictLRU()
+}
+
+// evictLRU removes the oldest (by mtime) cache entries until the cache's
+// total on-disk size is at or under h.maxSize. A MaxSize of 0 disables the
+// size cap entirely.
+func (h *httpCache) evictLRU() error {
+	if h.maxSize <= 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(h.path)
+	if err != nil {
+		return fmt.Errorf("failed to read cache directory: %w", err)
+	}
+
+	type fileInfo struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var files []fileInfo
+	var total int64
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		total += info.Size()
+		files = append(files, fileInfo{path: filepath.Join(h.path, entry.Name()), size: info.Size(), modTime: info.ModTime()})
+	}
+	if total <= h.maxSize {
+		return nil
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.Before(files[j].modTime) })
+	for _, f := range files {
+		if total <= h.maxSize {
+			break
+		}
+		if err := os.Remove(f.path); err != nil {
+			continue
+		}
+		total -= f.size
+	}
+
+	return nil
+}
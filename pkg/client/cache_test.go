@@ -0,0 +1,156 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestCache(t *testing.T, lifetime string, maxSize int64) *httpCache {
+	t.Helper()
+	cache, err := newHTTPCache(CacheConfig{Path: t.TempDir(), Lifetime: lifetime, MaxSize: maxSize})
+	if err != nil {
+		t.Fatalf("newHTTPCache() error = %v", err)
+	}
+	return cache
+}
+
+func TestHTTPCachePutGet(t *testing.T) {
+	cache := newTestCache(t, "1h", 0)
+
+	if err := cache.put("http://example.com/a", []byte("hello")); err != nil {
+		t.Fatalf("put() error = %v", err)
+	}
+
+	body, ok := cache.get("http://example.com/a", false)
+	if !ok {
+		t.Fatal("get() = false after put(), want true")
+	}
+	if string(body) != "hello" {
+		t.Errorf("get() body = %q, want %q", body, "hello")
+	}
+}
+
+func TestHTTPCacheGetMissForUnknownURL(t *testing.T) {
+	cache := newTestCache(t, "1h", 0)
+
+	if _, ok := cache.get("http://example.com/never-put", false); ok {
+		t.Error("get() = true for a URL that was never put, want false")
+	}
+}
+
+func TestHTTPCacheRefreshForcesMiss(t *testing.T) {
+	cache := newTestCache(t, "1h", 0)
+
+	if err := cache.put("http://example.com/a", []byte("hello")); err != nil {
+		t.Fatalf("put() error = %v", err)
+	}
+
+	if _, ok := cache.get("http://example.com/a", true); ok {
+		t.Error("get(refresh=true) = true, want a forced miss")
+	}
+}
+
+func TestHTTPCacheExpiresAfterLifetime(t *testing.T) {
+	cache := newTestCache(t, "20ms", 0)
+
+	if err := cache.put("http://example.com/a", []byte("hello")); err != nil {
+		t.Fatalf("put() error = %v", err)
+	}
+
+	if _, ok := cache.get("http://example.com/a", false); !ok {
+		t.Fatal("get() immediately after put() = false, want a hit")
+	}
+
+	time.Sleep(40 * time.Millisecond)
+
+	if _, ok := cache.get("http://example.com/a", false); ok {
+		t.Error("get() after the lifetime elapsed = true, want a miss")
+	}
+}
+
+// entrySize returns the total on-disk size (body + sidecar metadata) of the
+// cache entry for rawURL, so eviction-boundary tests can set MaxSize from
+// measured sizes instead of guessing at cacheMeta's encoded JSON length.
+func entrySize(t *testing.T, cache *httpCache, rawURL string) int64 {
+	t.Helper()
+	key := cacheKey(rawURL)
+	var total int64
+	for _, p := range []string{cache.bodyPath(key), cache.metaPath(key)} {
+		info, err := os.Stat(p)
+		if err != nil {
+			t.Fatalf("stat %s: %v", p, err)
+		}
+		total += info.Size()
+	}
+	return total
+}
+
+func TestHTTPCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newTestCache(t, "1h", 1<<20) // effectively unlimited until tightened below
+
+	if err := cache.put("http://example.com/oldest", []byte("aaaaa")); err != nil {
+		t.Fatalf("put(oldest) error = %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if err := cache.put("http://example.com/middle", []byte("bbbbb")); err != nil {
+		t.Fatalf("put(middle) error = %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if err := cache.put("http://example.com/newest", []byte("ccccc")); err != nil {
+		t.Fatalf("put(newest) error = %v", err)
+	}
+
+	middleSize := entrySize(t, cache, "http://example.com/middle")
+	newestSize := entrySize(t, cache, "http://example.com/newest")
+
+	// Tighten the budget to fit everything except the oldest entry, then
+	// run eviction directly: this should remove exactly the LRU entry.
+	cache.maxSize = middleSize + newestSize
+	if err := cache.evictLRU(); err != nil {
+		t.Fatalf("evictLRU() error = %v", err)
+	}
+
+	if _, ok := cache.get("http://example.com/oldest", false); ok {
+		t.Error("get(oldest) = true after eviction, want the LRU entry to be gone")
+	}
+	if _, ok := cache.get("http://example.com/middle", false); !ok {
+		t.Error("get(middle) = false, want it to survive eviction")
+	}
+	if _, ok := cache.get("http://example.com/newest", false); !ok {
+		t.Error("get(newest) = false, want it to survive eviction")
+	}
+}
+
+func TestHTTPCacheMaxSizeZeroDisablesEviction(t *testing.T) {
+	cache := newTestCache(t, "1h", 0)
+
+	for _, url := range []string{"http://example.com/a", "http://example.com/b", "http://example.com/c"} {
+		if err := cache.put(url, []byte("some content that would exceed a tiny budget")); err != nil {
+			t.Fatalf("put(%s) error = %v", url, err)
+		}
+	}
+
+	for _, url := range []string{"http://example.com/a", "http://example.com/b", "http://example.com/c"} {
+		if _, ok := cache.get(url, false); !ok {
+			t.Errorf("get(%s) = false, want all entries to survive with MaxSize 0", url)
+		}
+	}
+}
+
+func TestNewHTTPCacheRejectsInvalidLifetime(t *testing.T) {
+	if _, err := newHTTPCache(CacheConfig{Path: t.TempDir(), Lifetime: "not-a-duration"}); err == nil {
+		t.Error("newHTTPCache() with an invalid lifetime should return an error")
+	}
+}
+
+func TestNewHTTPCacheCreatesDirectory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "cache")
+	if _, err := newHTTPCache(CacheConfig{Path: path, Lifetime: "1h"}); err != nil {
+		t.Fatalf("newHTTPCache() error = %v", err)
+	}
+	if info, err := os.Stat(path); err != nil || !info.IsDir() {
+		t.Errorf("newHTTPCache() did not create %s as a directory", path)
+	}
+}
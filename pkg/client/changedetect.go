@@ -0,0 +1,181 @@
+package client
+
+import (
+	"strings"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// DefaultChangeThreshold is the normalized edit-distance above which a
+// profile is considered changed by the default ChangeDetector.
+const DefaultChangeThreshold = 0.05
+
+// ChangeDetector decides whether newProfile is meaningfully different from
+// oldProfile, so ScrapeAll can skip rewriting profiles that only shifted
+// whitespace or made edits below the change threshold.
+type ChangeDetector interface {
+	Changed(oldProfile, newProfile *models.Profile) bool
+}
+
+// WithChangeDetector overrides the ChangeDetector used by ScrapeAll's
+// incremental update check (default: LevenshteinChangeDetector with
+// DefaultChangeThreshold).
+func WithChangeDetector(d ChangeDetector) Option {
+	return func(c *Client) {
+		c.changeDetector = d
+	}
+}
+
+// LevenshteinChangeDetector compares descriptions by token-level Levenshtein
+// distance and Pros/Cons by set-edit-distance over the bullet strings,
+// flagging a change only when the normalized distance exceeds Threshold.
+type LevenshteinChangeDetector struct {
+	Threshold float64
+}
+
+// NewLevenshteinChangeDetector returns a LevenshteinChangeDetector using
+// DefaultChangeThreshold.
+func NewLevenshteinChangeDetector() *LevenshteinChangeDetector {
+	return &LevenshteinChangeDetector{Threshold: DefaultChangeThreshold}
+}
+
+// Changed reports whether newProfile differs enough from oldProfile to
+// warrant rewriting it to disk.
+func (d *LevenshteinChangeDetector) Changed(oldProfile, newProfile *models.Profile) bool {
+	if oldProfile.Verdict != newProfile.Verdict {
+		return true
+	}
+
+	threshold := d.Threshold
+	if threshold == 0 {
+		threshold = DefaultChangeThreshold
+	}
+
+	descDist := normalizedTokenDistance(oldProfile.Description, newProfile.Description)
+	if descDist > threshold {
+		return true
+	}
+
+	prosDist := normalizedSliceDistance(oldProfile.Pros, newProfile.Pros)
+	if prosDist > threshold {
+		return true
+	}
+
+	consDist := normalizedSliceDistance(oldProfile.Cons, newProfile.Cons)
+	return consDist > threshold
+}
+
+// normalizedTokenDistance tokenizes a and b on whitespace and returns their
+// Wagner-Fischer edit distance normalized by the longer token count.
+func normalizedTokenDistance(a, b string) float64 {
+	return normalizedSliceDistance(strings.Fields(a), strings.Fields(b))
+}
+
+// normalizedSliceDistance computes the Levenshtein edit distance between two
+// ordered string slices (tokens or bullet entries) and normalizes it by the
+// length of the longer slice.
+func normalizedSliceDistance(a, b []string) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+
+	dist := levenshtein(a, b)
+	maxLen := len(a)
+	if len(b) > maxLen {
+		maxLen = len(b)
+	}
+	return float64(dist) / float64(maxLen)
+}
+
+// DiffBullets returns a human-readable edit script (insert/delete/substitute
+// operations) turning oldItems into newItems, computed via backtracking
+// through the same Wagner-Fischer DP table used by levenshtein. ScrapeAll
+// logs this alongside an UPDATED line so operators can see *what* changed in
+// a profile's Pros/Cons, not just that something did.
+func DiffBullets(oldItems, newItems []string) []string {
+	m, n := len(oldItems), len(newItems)
+	d := make([][]int, m+1)
+	for i := range d {
+		d[i] = make([]int, n+1)
+		d[i][0] = i
+	}
+	for j := 0; j <= n; j++ {
+		d[0][j] = j
+	}
+	for i := 1; i <= m; i++ {
+		for j := 1; j <= n; j++ {
+			cost := 1
+			if oldItems[i-1] == newItems[j-1] {
+				cost = 0
+			}
+			best := d[i-1][j] + 1
+			if v := d[i][j-1] + 1; v < best {
+				best = v
+			}
+			if v := d[i-1][j-1] + cost; v < best {
+				best = v
+			}
+			d[i][j] = best
+		}
+	}
+
+	var ops []string
+	i, j := m, n
+	for i > 0 || j > 0 {
+		switch {
+		case i > 0 && j > 0 && oldItems[i-1] == newItems[j-1]:
+			i--
+			j--
+		case i > 0 && j > 0 && d[i][j] == d[i-1][j-1]+1:
+			ops = append([]string{"~ " + oldItems[i-1] + " -> " + newItems[j-1]}, ops...)
+			i--
+			j--
+		case i > 0 && d[i][j] == d[i-1][j]+1:
+			ops = append([]string{"- " + oldItems[i-1]}, ops...)
+			i--
+		default:
+			ops = append([]string{"+ " + newItems[j-1]}, ops...)
+			j--
+		}
+	}
+
+	return ops
+}
+
+// levenshtein computes the classic Wagner-Fischer edit distance between two
+// token sequences: d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost),
+// cost = 0 when tokens are equal, 1 otherwise.
+func levenshtein(a, b []string) int {
+	m, n := len(a), len(b)
+	d := make([][]int, m+1)
+	for i := range d {
+		d[i] = make([]int, n+1)
+		d[i][0] = i
+	}
+	for j := 0; j <= n; j++ {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= m; i++ {
+		for j := 1; j <= n; j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			deletion := d[i-1][j] + 1
+			insertion := d[i][j-1] + 1
+			substitution := d[i-1][j-1] + cost
+
+			best := deletion
+			if insertion < best {
+				best = insertion
+			}
+			if substitution < best {
+				best = substitution
+			}
+			d[i][j] = best
+		}
+	}
+
+	return d[m][n]
+}
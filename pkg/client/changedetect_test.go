@@ -0,0 +1,115 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want int
+	}{
+		{"both empty", nil, nil, 0},
+		{"identical", []string{"a", "b", "c"}, []string{"a", "b", "c"}, 0},
+		{"one insertion", []string{"a", "b"}, []string{"a", "b", "c"}, 1},
+		{"one deletion", []string{"a", "b", "c"}, []string{"a", "b"}, 1},
+		{"one substitution", []string{"a", "b", "c"}, []string{"a", "x", "c"}, 1},
+		{"all different", []string{"a", "b"}, []string{"x", "y", "z"}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := levenshtein(tt.a, tt.b); got != tt.want {
+				t.Errorf("levenshtein(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizedSliceDistance(t *testing.T) {
+	if d := normalizedSliceDistance(nil, nil); d != 0 {
+		t.Errorf("normalizedSliceDistance(nil, nil) = %v, want 0", d)
+	}
+	if d := normalizedSliceDistance([]string{"a", "b"}, []string{"a", "b"}); d != 0 {
+		t.Errorf("normalizedSliceDistance of identical slices = %v, want 0", d)
+	}
+	// One substitution out of two tokens normalizes to 0.5.
+	if d := normalizedSliceDistance([]string{"a", "b"}, []string{"a", "x"}); d != 0.5 {
+		t.Errorf("normalizedSliceDistance = %v, want 0.5", d)
+	}
+}
+
+func TestLevenshteinChangeDetectorChanged(t *testing.T) {
+	d := NewLevenshteinChangeDetector()
+
+	base := &models.Profile{
+		Verdict:     "Jew",
+		Description: "a short description of this person",
+		Pros:        []string{"one", "two", "three"},
+		Cons:        []string{"four"},
+	}
+
+	t.Run("identical profile is unchanged", func(t *testing.T) {
+		other := *base
+		if d.Changed(base, &other) {
+			t.Error("Changed() = true for an identical profile, want false")
+		}
+	})
+
+	t.Run("verdict flip is always a change", func(t *testing.T) {
+		other := *base
+		other.Verdict = "Not a Jew"
+		if !d.Changed(base, &other) {
+			t.Error("Changed() = false after a verdict flip, want true")
+		}
+	})
+
+	t.Run("whitespace-only tweak is unchanged", func(t *testing.T) {
+		other := *base
+		other.Description = "a short description of this  person" // one extra space, same tokens
+		if d.Changed(base, &other) {
+			t.Error("Changed() = true for a whitespace-only tweak, want false")
+		}
+	})
+
+	t.Run("rewritten description exceeds threshold", func(t *testing.T) {
+		other := *base
+		other.Description = "a completely different sentence about somebody else entirely"
+		if !d.Changed(base, &other) {
+			t.Error("Changed() = false for a rewritten description, want true")
+		}
+	})
+
+	t.Run("custom threshold of 1 never trips on token edits", func(t *testing.T) {
+		lenient := &LevenshteinChangeDetector{Threshold: 1}
+		other := *base
+		other.Description = "a completely different sentence about somebody else entirely"
+		if lenient.Changed(base, &other) {
+			t.Error("Changed() = true with Threshold 1, want false")
+		}
+	})
+}
+
+func TestDiffBullets(t *testing.T) {
+	tests := []struct {
+		name          string
+		oldItems, new []string
+		want          []string
+	}{
+		{"no change", []string{"a", "b"}, []string{"a", "b"}, nil},
+		{"append", []string{"a"}, []string{"a", "b"}, []string{"+ b"}},
+		{"remove", []string{"a", "b"}, []string{"a"}, []string{"- b"}},
+		{"substitute", []string{"a"}, []string{"b"}, []string{"~ a -> b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffBullets(tt.oldItems, tt.new)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffBullets(%v, %v) = %v, want %v", tt.oldItems, tt.new, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
@@ -17,10 +19,22 @@ import (
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
+	"github.com/marcusziade/jewornotjew/pkg/adapter"
+	"github.com/marcusziade/jewornotjew/pkg/assets"
+	"github.com/marcusziade/jewornotjew/pkg/classify"
+	"github.com/marcusziade/jewornotjew/pkg/extractors"
+	"github.com/marcusziade/jewornotjew/pkg/index"
+	"github.com/marcusziade/jewornotjew/pkg/metrics"
 	"github.com/marcusziade/jewornotjew/pkg/models"
+	"github.com/marcusziade/jewornotjew/pkg/politeness"
+	"github.com/marcusziade/jewornotjew/pkg/queue"
+	"github.com/marcusziade/jewornotjew/pkg/store"
 	"github.com/schollz/progressbar/v3"
 )
 
+// defaultUserAgent identifies this scraper to sites it crawls.
+const defaultUserAgent = "JewOrNotJewKit/1.0 (+https://github.com/marcusziade/JewOrNotJewKit)"
+
 // Client represents the JewOrNotJew API client
 type Client struct {
 	baseURL    string
@@ -28,6 +42,59 @@ type Client struct {
 	dataDir    string
 	profiles   map[string]*models.Profile
 	mu         sync.Mutex // Mutex for thread safety
+
+	// adapter, when set, drives ScrapeAll/scrapeProfile instead of the
+	// built-in jewornotjew.com heuristics, letting callers target other
+	// sites without editing this package. See WithAdapter/WithAdapterConfig.
+	adapter adapter.SiteAdapter
+
+	// extractors is the ordered list of ProfileExtractor backends scrapeProfile
+	// tries per field, each only filling in what the previous one left unset.
+	// Defaults to extractors.DefaultChain(adapter) if never set. See
+	// WithExtractors.
+	extractors extractors.Chain
+
+	// changeDetector decides whether a re-scraped profile has meaningfully
+	// changed from the version already on disk. See WithChangeDetector.
+	changeDetector ChangeDetector
+
+	// politeness governs robots.txt compliance, per-host rate limiting, and
+	// retry/backoff for profile and image fetches. The homepage fetch in
+	// scrapeAllWithAdapter goes through httpClient directly.
+	politeness *politeness.Policy
+
+	// store, when set, backs saveProfileToJSON/LoadFromDisk instead of the
+	// default one-JSON-file-per-profile layout. See WithStore.
+	store store.Store
+
+	// metrics records scrape outcomes and request latency; it exists
+	// regardless of whether an HTTP endpoint is exposed for it. See
+	// WithMetricsAddr.
+	metrics *metrics.Metrics
+
+	// metricsAddr, when non-empty, is the address ScrapeAll serves
+	// Prometheus metrics and net/http/pprof handlers on. See WithMetricsAddr.
+	metricsAddr string
+
+	// cache, when set, backs scrapeProfile/scrapeProfileWithAdapter's HTTP
+	// fetches with an on-disk, TTL-expiring, size-capped cache so repeated
+	// ScrapeAll runs only re-fetch what's actually stale. See WithCache.
+	cache *httpCache
+
+	// cacheRefresh forces every fetch to bypass the cache's freshness
+	// check (the entry is still overwritten with the new response). See
+	// WithCacheRefresh.
+	cacheRefresh bool
+
+	// searchIndex is an in-memory BM25 index over c.profiles, rebuilt from
+	// scratch on LoadFromDisk and updated incrementally on AddProfile. See
+	// pkg/index and Client.Search.
+	searchIndex *index.Index
+
+	// classifier predicts Category for profiles that don't already have
+	// one, trained from c.profiles' already-labeled entries on every
+	// LoadFromDisk. See pkg/classify and Client.Reclassify.
+	classifier *classify.Classifier
 }
 
 // NewClient creates a new JewOrNotJew client
@@ -40,8 +107,13 @@ func NewClient(options ...Option) (*Client, error) {
 				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 			},
 		},
-		dataDir:  "./data",
-		profiles: make(map[string]*models.Profile),
+		dataDir:        "./data",
+		profiles:       make(map[string]*models.Profile),
+		changeDetector: NewLevenshteinChangeDetector(),
+		politeness:     politeness.NewPolicy(defaultUserAgent, 3, 100, false),
+		metrics:        metrics.New(),
+		searchIndex:    index.New(),
+		classifier:     classify.New(),
 	}
 
 	// Apply options
@@ -49,6 +121,14 @@ func NewClient(options ...Option) (*Client, error) {
 		option(c)
 	}
 
+	if c.extractors == nil {
+		backend := adapter.SiteAdapter(adapter.Default())
+		if c.adapter != nil {
+			backend = c.adapter
+		}
+		c.extractors = extractors.DefaultChain(backend)
+	}
+
 	// Create data directory if it doesn't exist
 	if err := os.MkdirAll(c.dataDir, 0755); err != nil {
 		return nil, fmt.Errorf("failed to create data directory: %w", err)
@@ -81,10 +161,182 @@ func WithDataDir(dataDir string) Option {
 	}
 }
 
+// WithAdapter sets the SiteAdapter used to discover and parse profiles,
+// overriding the built-in jewornotjew.com heuristics.
+func WithAdapter(a adapter.SiteAdapter) Option {
+	return func(c *Client) {
+		c.adapter = a
+	}
+}
+
+// WithAdapterConfig loads a SiteAdapter from a YAML/JSON config file (see
+// pkg/adapter) and uses it in place of the built-in heuristics. Errors
+// loading the config are swallowed at apply time and surfaced by falling
+// back to the built-in adapter; callers that need to observe the error
+// should call adapter.LoadAdapter themselves and pass WithAdapter instead.
+func WithAdapterConfig(path string) Option {
+	return func(c *Client) {
+		if a, err := adapter.LoadAdapter(path); err == nil {
+			c.adapter = a
+		}
+	}
+}
+
+// WithExtractors overrides the ordered list of ProfileExtractor backends
+// scrapeProfile tries per field, in place of the built-in
+// extractors.DefaultChain (declarative selectors, then OpenGraph, then
+// JSON-LD). Useful for registering a custom backend, or for dropping down to
+// just the backends a given site actually supports.
+func WithExtractors(exs ...extractors.ProfileExtractor) Option {
+	return func(c *Client) {
+		c.extractors = exs
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request and used
+// to identify this crawler to robots.txt.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.politeness.UserAgent = userAgent
+	}
+}
+
+// WithMaxRetries sets how many attempts in total a request gets on transient
+// errors (network failures, 429/502/503/504) before giving up.
+func WithMaxRetries(maxRetries int) Option {
+	return func(c *Client) {
+		c.politeness.MaxRetries = maxRetries
+	}
+}
+
+// WithRequestsPerSecond caps the request rate per host (subject to any
+// stricter Crawl-delay found in robots.txt).
+func WithRequestsPerSecond(rps float64) Option {
+	return func(c *Client) {
+		c.politeness.RequestsPerSecond = rps
+	}
+}
+
+// WithRespectRobots enables or disables robots.txt compliance.
+func WithRespectRobots(respect bool) Option {
+	return func(c *Client) {
+		c.politeness.RespectRobots = respect
+	}
+}
+
+// WithStore backs profile persistence (saveProfileToJSON/LoadFromDisk) with
+// store instead of the default one-JSON-file-per-profile directory layout.
+// See pkg/store for the JSON, SQLite (FTS5), and Postgres implementations.
+func WithStore(s store.Store) Option {
+	return func(c *Client) {
+		c.store = s
+	}
+}
+
+// WithMetricsAddr starts an HTTP server on addr (e.g. ":9090") when ScrapeAll
+// runs, exposing Prometheus metrics at /metrics and net/http/pprof handlers
+// under /debug/pprof/ for heap/goroutine inspection during long crawls. See
+// pkg/metrics for the collectors themselves. The adapter-driven ScrapeAll
+// (see WithAdapter) does not start this server.
+func WithMetricsAddr(addr string) Option {
+	return func(c *Client) {
+		c.metricsAddr = addr
+	}
+}
+
+// WithCache backs HTTP fetches with an on-disk cache per cfg, so repeated
+// ScrapeAll runs serve unchanged URLs from disk instead of re-hitting the
+// source. A disabled or unparseable cfg leaves fetching uncached, with a
+// warning printed for the latter.
+func WithCache(cfg CacheConfig) Option {
+	return func(c *Client) {
+		if !cfg.Enabled {
+			return
+		}
+		cache, err := newHTTPCache(cfg)
+		if err != nil {
+			fmt.Printf("⚠️ Warning: -cache requested but could not be set up, fetching uncached: %v\n", err)
+			return
+		}
+		c.cache = cache
+	}
+}
+
+// WithCacheRefresh forces every fetch to bypass WithCache's freshness
+// check and re-fetch from the source, overwriting the stale cache entry
+// with the fresh response. Has no effect without WithCache.
+func WithCacheRefresh(refresh bool) Option {
+	return func(c *Client) {
+		c.cacheRefresh = refresh
+	}
+}
+
+// fetchURL returns rawURL's body, consulting c.cache first when one is
+// configured and falling back to an HTTP request (paced/retried by
+// c.politeness the same way every other scrape request is) on a cache
+// miss, storing the result back in the cache before returning.
+func (c *Client) fetchURL(ctx context.Context, rawURL string) ([]byte, error) {
+	if c.cache != nil {
+		if body, ok := c.cache.get(rawURL, c.cacheRefresh); ok {
+			return body, nil
+		}
+	}
+
+	if allowed, err := c.politeness.Allowed(ctx, c.httpClient, rawURL); err == nil && !allowed {
+		return nil, fmt.Errorf("robots.txt disallows %s", rawURL)
+	}
+	if err := c.politeness.Wait(ctx, rawURL); err != nil {
+		return nil, fmt.Errorf("rate limiter wait failed: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.politeness.Do(ctx, c.httpClient, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received non-200 response: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if c.cache != nil {
+		if err := c.cache.put(rawURL, body); err != nil {
+			fmt.Printf("⚠️ Warning: failed to write cache entry for %s: %v\n", rawURL, err)
+		}
+	}
+
+	return body, nil
+}
+
 // ScrapeAll scrapes all profiles from the website
 func (c *Client) ScrapeAll(incrementalMode bool) error {
+	if c.adapter != nil {
+		return c.scrapeAllWithAdapter(incrementalMode)
+	}
+
 	fmt.Println("Starting scrape operation...")
-	
+
+	if c.metricsAddr != "" {
+		metricsCtx, stopMetrics := context.WithCancel(context.Background())
+		defer stopMetrics()
+		go func() {
+			if err := c.metrics.Serve(metricsCtx, c.metricsAddr); err != nil {
+				fmt.Printf("⚠️ Warning: metrics server stopped: %v\n", err)
+			}
+		}()
+		fmt.Printf("📈 Metrics: http://%s/metrics, pprof: http://%s/debug/pprof/\n", c.metricsAddr, c.metricsAddr)
+	}
+
 	// Load existing profiles from disk if in incremental mode
 	if incrementalMode {
 		fmt.Println("🔄 Incremental mode: Loading existing profiles from disk first...")
@@ -219,13 +471,15 @@ func (c *Client) ScrapeAll(incrementalMode bool) error {
 			profile, err := c.scrapeProfile(id)
 			if err != nil {
 				atomic.AddInt64(&failCounter, 1)
+				c.metrics.ProfilesTotal.WithLabelValues("failed").Inc()
 				// Log error to file only
 				log(fmt.Sprintf("Error scraping ID %d: %v", id, err))
 				return
 			}
-			
+
 			if profile == nil || profile.Name == "" || profile.Name == fmt.Sprintf("Profile %d", id) {
 				atomic.AddInt64(&skipCounter, 1)
+				c.metrics.ProfilesTotal.WithLabelValues("skipped").Inc()
 				return
 			}
 			
@@ -242,6 +496,7 @@ func (c *Client) ScrapeAll(incrementalMode bool) error {
 				c.mu.Unlock()
 				
 				atomic.AddInt64(&newCounter, 1)
+				c.metrics.ProfilesTotal.WithLabelValues("new").Inc()
 				log(fmt.Sprintf("✅ NEW: ID %d → %s", id, profile.Name))
 				
 				// Save profile to JSON
@@ -249,21 +504,29 @@ func (c *Client) ScrapeAll(incrementalMode bool) error {
 					log(fmt.Sprintf("Error saving %s: %v", profile.Name, err))
 				}
 			} else {
-				// Check if profile needs update (compare basic fields)
-				if existingProfile.Verdict != profile.Verdict || 
-				   existingProfile.Description != profile.Description || 
-				   len(existingProfile.Pros) != len(profile.Pros) || 
-				   len(existingProfile.Cons) != len(profile.Cons) {
-					
+				// Check if profile needs update via the configured ChangeDetector
+				// (default: Levenshtein distance on description + Pros/Cons,
+				// so stray whitespace or an edit below the threshold doesn't
+				// trigger a rewrite on every run; reordered bullets do count
+				// as a change).
+				if c.changeDetector.Changed(existingProfile, profile) {
+
 					// Update the profile
 					profile.CreatedAt = existingProfile.CreatedAt // Preserve original creation date
 					profile.UpdatedAt = time.Now().Format(time.RFC3339) // Set new update date
 					c.profiles[profile.Name] = profile
 					c.mu.Unlock()
-					
+
 					atomic.AddInt64(&updatedCounter, 1)
+					c.metrics.ProfilesTotal.WithLabelValues("updated").Inc()
 					log(fmt.Sprintf("🔄 UPDATED: ID %d → %s", id, profile.Name))
-					
+					if ops := DiffBullets(existingProfile.Pros, profile.Pros); len(ops) > 0 {
+						log(fmt.Sprintf("   pros diff: %v", ops))
+					}
+					if ops := DiffBullets(existingProfile.Cons, profile.Cons); len(ops) > 0 {
+						log(fmt.Sprintf("   cons diff: %v", ops))
+					}
+
 					// Save updated profile to JSON
 					if err := c.saveProfileToJSON(profile); err != nil {
 						log(fmt.Sprintf("Error saving updated %s: %v", profile.Name, err))
@@ -272,6 +535,7 @@ func (c *Client) ScrapeAll(incrementalMode bool) error {
 					// Profile exists and hasn't changed
 					c.mu.Unlock()
 					atomic.AddInt64(&skippedCounter, 1)
+					c.metrics.ProfilesTotal.WithLabelValues("skipped").Inc()
 					log(fmt.Sprintf("⏭️ SKIPPED: ID %d → %s (no changes)", id, profile.Name))
 				}
 			}
@@ -301,6 +565,172 @@ func (c *Client) ScrapeAll(incrementalMode bool) error {
 	return nil
 }
 
+// ResumeScrape scrapes profiles through a persistent on-disk visit queue
+// (pkg/queue) instead of the in-memory ID loop used by ScrapeAll, so a
+// killed run can pick back up where it left off instead of rescanning
+// everything. It opens (or reuses) "<dataDir>/visitqueue.db", re-enqueues
+// any IDs left in-flight from a previous, killed run, then works through
+// pending/failed-retryable IDs until ctx is cancelled or the queue drains.
+func (c *Client) ResumeScrape(ctx context.Context) error {
+	q, err := queue.Open(filepath.Join(c.dataDir, "visitqueue.db"))
+	if err != nil {
+		return fmt.Errorf("failed to open visit queue: %w", err)
+	}
+	defer q.Close()
+
+	maxID := 10000
+	if c.adapter != nil {
+		if count := c.adapter.ExpectedCount(); count > 0 {
+			maxID = count * 3 // headroom for gaps, mirroring the legacy ceiling
+		}
+	}
+	for id := 1; id <= maxID; id++ {
+		if err := q.Enqueue(id); err != nil {
+			return fmt.Errorf("failed to enqueue ID %d: %w", id, err)
+		}
+	}
+
+	ids, err := q.ResumeIDs()
+	if err != nil {
+		return fmt.Errorf("failed to compute resume set: %w", err)
+	}
+	fmt.Printf("🔄 Resuming scrape: %d IDs pending\n", len(ids))
+
+	for _, id := range ids {
+		select {
+		case <-ctx.Done():
+			fmt.Println("Graceful shutdown requested, leaving remaining IDs in queue")
+			return ctx.Err()
+		default:
+		}
+
+		if err := q.SetState(id, queue.InFlight); err != nil {
+			return fmt.Errorf("failed to mark ID %d in-flight: %w", id, err)
+		}
+
+		var profile *models.Profile
+		var scrapeErr error
+		if c.adapter != nil {
+			profile, scrapeErr = c.scrapeProfileWithAdapter(id)
+		} else {
+			profile, scrapeErr = c.scrapeProfile(id)
+		}
+
+		if scrapeErr != nil {
+			_ = q.SetState(id, queue.FailedRetryable)
+			continue
+		}
+		if profile == nil || profile.Name == "" || profile.Name == fmt.Sprintf("Profile %d", id) {
+			_ = q.SetState(id, queue.NotFound)
+			continue
+		}
+
+		c.mu.Lock()
+		c.profiles[profile.Name] = profile
+		c.mu.Unlock()
+
+		if err := c.saveProfileToJSON(profile); err != nil {
+			fmt.Printf("Error saving %s: %v\n", profile.Name, err)
+		}
+		_ = q.SetState(id, queue.Success)
+	}
+
+	return nil
+}
+
+// scrapeAllWithAdapter drives ScrapeAll through c.adapter instead of the
+// built-in jewornotjew.com heuristics: it fetches the adapter's homepage to
+// discover IDs, then fetches and parses each profile URL the same way the
+// legacy path does.
+func (c *Client) scrapeAllWithAdapter(incrementalMode bool) error {
+	fmt.Println("Starting scrape operation (adapter-driven)...")
+
+	if incrementalMode {
+		fmt.Println("🔄 Incremental mode: Loading existing profiles from disk first...")
+		if err := c.LoadFromDisk(); err != nil {
+			fmt.Printf("⚠️ Warning: Failed to load profiles from disk: %v\n", err)
+		}
+	}
+
+	resp, err := c.httpClient.Get(c.adapter.HomepageURL())
+	if err != nil {
+		return fmt.Errorf("failed to retrieve homepage: %w", err)
+	}
+	defer resp.Body.Close()
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to parse homepage: %w", err)
+	}
+
+	ids := c.adapter.DiscoverIDs(doc)
+	total := c.adapter.ExpectedCount()
+	if total == 0 {
+		total = len(ids)
+	}
+
+	bar := progressbar.Default(int64(len(ids)), "Scraping profiles")
+
+	var newCounter, updatedCounter, failCounter int64
+	for _, id := range ids {
+		profile, err := c.scrapeProfileWithAdapter(id)
+		bar.Add(1)
+		if err != nil {
+			atomic.AddInt64(&failCounter, 1)
+			continue
+		}
+
+		c.mu.Lock()
+		if existing, exists := c.profiles[profile.Name]; exists {
+			profile.CreatedAt = existing.CreatedAt
+			profile.UpdatedAt = time.Now().Format(time.RFC3339)
+			atomic.AddInt64(&updatedCounter, 1)
+		} else {
+			atomic.AddInt64(&newCounter, 1)
+		}
+		c.profiles[profile.Name] = profile
+		c.mu.Unlock()
+
+		if err := c.saveProfileToJSON(profile); err != nil {
+			fmt.Printf("Error saving %s: %v\n", profile.Name, err)
+		}
+	}
+
+	fmt.Printf("\n✅ Scraping complete! %d new / %d updated / %d failed (expected ~%d)\n",
+		newCounter, updatedCounter, failCounter, total)
+
+	return nil
+}
+
+// scrapeProfileWithAdapter fetches and parses a single profile via c.adapter.
+func (c *Client) scrapeProfileWithAdapter(id int) (*models.Profile, error) {
+	profileURL := c.adapter.ProfileURL(id)
+
+	ctx := context.Background()
+	body, err := c.fetchURL(ctx, profileURL)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	profile := &models.Profile{URL: profileURL}
+	profile = c.adapter.ParseProfile(doc, profile)
+
+	if profile.Name == "" {
+		profile.Name = fmt.Sprintf("Profile %d", id)
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	profile.CreatedAt = now
+	profile.UpdatedAt = now
+
+	return profile, nil
+}
+
 // getProfileIDs gets all profile IDs from the website
 func (c *Client) getProfileIDs() ([]int, error) {
 	// Make direct HTTP request
@@ -347,25 +777,59 @@ func (c *Client) scrapeProfile(id int) (*models.Profile, error) {
 	profileURL := fmt.Sprintf("%s/profile.jsp?ID=%d", c.baseURL, id)
 	// Only print detailed info for every 100th profile to avoid log flooding
 	verbose := id%1000 == 0
-	
-	// Make HTTP request
-	resp, err := c.httpClient.Get(profileURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve profile: %w", err)
-	}
-	defer resp.Body.Close()
-	
-	// Check response status
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("received non-200 response: %d", resp.StatusCode)
+
+	ctx := context.Background()
+
+	var bodyContent []byte
+	if c.cache != nil {
+		if cached, ok := c.cache.get(profileURL, c.cacheRefresh); ok {
+			bodyContent = cached
+		}
 	}
-	
-	// Read the body content
-	bodyContent, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+
+	if bodyContent == nil {
+		if allowed, err := c.politeness.Allowed(ctx, c.httpClient, profileURL); err == nil && !allowed {
+			return nil, fmt.Errorf("robots.txt disallows %s", profileURL)
+		}
+		if err := c.politeness.Wait(ctx, profileURL); err != nil {
+			return nil, fmt.Errorf("rate limiter wait failed: %w", err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, profileURL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build request: %w", err)
+		}
+
+		// Make HTTP request, with robots.txt/rate-limit/backoff handled by politeness.Policy
+		c.metrics.InFlightRequests.Inc()
+		requestStart := time.Now()
+		resp, err := c.politeness.Do(ctx, c.httpClient, req)
+		c.metrics.InFlightRequests.Dec()
+		if err != nil {
+			c.metrics.ObserveRequest(time.Since(requestStart), 0)
+			return nil, fmt.Errorf("failed to retrieve profile: %w", err)
+		}
+		defer resp.Body.Close()
+		c.metrics.ObserveRequest(time.Since(requestStart), resp.StatusCode)
+
+		// Check response status
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("received non-200 response: %d", resp.StatusCode)
+		}
+
+		// Read the body content
+		bodyContent, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		if c.cache != nil {
+			if err := c.cache.put(profileURL, bodyContent); err != nil {
+				fmt.Printf("⚠️ Warning: failed to write cache entry for %s: %v\n", profileURL, err)
+			}
+		}
 	}
-	
+
 	// Print debug info only in verbose mode
 	if verbose && len(bodyContent) > 0 {
 		// Print the first 200 characters of the HTML for debugging (reduced from 1000)
@@ -385,9 +849,11 @@ func (c *Client) scrapeProfile(id int) (*models.Profile, error) {
 		Score: float64(id), // Store the ID as score for now
 	}
 	
-	// Extract profile data
-	profile = c.parseProfile(doc, profile)
-	
+	// Extract profile data by trying each backend in c.extractors in turn,
+	// each only filling in fields the previous one left unset.
+	profile = c.extractors.Apply(doc, c.baseURL, profile)
+	resolveImageURL(c.baseURL, profile)
+
 	// Set ID in name if name is empty
 	if profile.Name == "" {
 		profile.Name = fmt.Sprintf("Profile %d", id)
@@ -401,608 +867,6 @@ func (c *Client) scrapeProfile(id int) (*models.Profile, error) {
 	return profile, nil
 }
 
-// parseProfile extracts profile data from HTML
-func (c *Client) parseProfile(doc *goquery.Document, profile *models.Profile) *models.Profile {
-	// Get ID from the URL to check if we should be verbose
-	id := int(profile.Score)
-	verbose := id%1000 == 0
-	
-	// Extract name from title
-	title := doc.Find("title").Text()
-	if title != "" {
-		parts := strings.Split(title, " - ")
-		if len(parts) > 0 {
-			name := strings.TrimSpace(parts[0])
-			// Remove "Jew or Not Jew: " prefix if present
-			name = strings.TrimPrefix(name, "Jew or Not Jew: ")
-			profile.Name = name
-			if verbose {
-				fmt.Printf("Extracted name from title: %s\n", profile.Name)
-			}
-		}
-	}
-	
-	// Extract name from h1 if not found in title
-	if profile.Name == "" {
-		doc.Find("h1").Each(func(i int, s *goquery.Selection) {
-			name := strings.TrimSpace(s.Text())
-			if name != "" {
-				profile.Name = name
-				if verbose {
-					fmt.Printf("Extracted name from h1: %s\n", name)
-				}
-			}
-		})
-	}
-	
-	// Extract verdict (after looking at the HTML structure)
-	verdictText := ""
-	// Try the meta description which often contains the verdict
-	metaDesc, exists := doc.Find("meta[name=description]").Attr("content")
-	if exists && metaDesc != "" {
-		if verbose {
-			fmt.Printf("Found meta description: %s\n", metaDesc)
-		}
-		// The meta description often follows the pattern "Is name Jewish?" or similar
-		// The verdict is usually at the end as a single word
-		metaDesc = strings.TrimSpace(metaDesc)
-		
-		if strings.Contains(metaDesc, "is ") && strings.HasSuffix(metaDesc, ".") {
-			words := strings.Split(metaDesc, " ")
-			if len(words) > 2 {
-				// The verdict is usually the last word without the period
-				lastWord := words[len(words)-1]
-				lastWord = strings.TrimSuffix(lastWord, ".")
-				if lastWord == "Jew" || lastWord == "Jewish" {
-					verdictText = "Jew"
-				} else if strings.Contains(lastWord, "Not") {
-					verdictText = "Not a Jew"
-				}
-				if verbose && verdictText != "" {
-					fmt.Printf("Extracted verdict from meta: %s\n", verdictText)
-				}
-			}
-		}
-	}
-	
-	// If no verdict found in meta, try other places
-	if verdictText == "" {
-		// Look for verdicts in the page content
-		doc.Find("font, div, b, p").Each(func(i int, s *goquery.Selection) {
-			text := strings.TrimSpace(s.Text())
-			lcText := strings.ToLower(text)
-			
-			// Check for common verdict patterns
-			if strings.Contains(lcText, "verdict:") {
-				parts := strings.SplitN(text, ":", 2)
-				if len(parts) > 1 {
-					verdict := strings.TrimSpace(parts[1])
-					if verdict != "" {
-						verdictText = verdict
-						if verbose {
-							fmt.Printf("Extracted verdict from page: %s\n", verdictText)
-						}
-					}
-				}
-			} else if strings.Contains(lcText, "verdict") && len(text) < 30 {
-				// Likely a verdict heading, check siblings or parents
-				parent := s.Parent()
-				if parent.Length() > 0 {
-					siblingText := strings.TrimSpace(parent.Text())
-					siblingText = strings.Replace(siblingText, text, "", 1)
-					siblingText = strings.TrimSpace(siblingText)
-					
-					if siblingText != "" && len(siblingText) < 30 {
-						verdictText = siblingText
-						if verbose {
-							fmt.Printf("Extracted verdict from sibling: %s\n", verdictText)
-						}
-					}
-				}
-			} else if (lcText == "jew" || lcText == "not a jew" || lcText == "barely a jew") && len(text) < 30 {
-				verdictText = text
-				if verbose {
-					fmt.Printf("Found direct verdict text: %s\n", verdictText)
-				}
-			}
-		})
-	}
-	
-	// If still no verdict found, infer it from the image if possible
-	if verdictText == "" {
-		imageUrl, exists := doc.Find("img[src*='img/']").Attr("src")
-		if exists && imageUrl != "" {
-			if strings.Contains(imageUrl, "verified_jew") {
-				verdictText = "Jew"
-				if verbose {
-					fmt.Printf("Inferred verdict from image: %s\n", verdictText)
-				}
-			} else if strings.Contains(imageUrl, "not_a_jew") {
-				verdictText = "Not a Jew"
-				if verbose {
-					fmt.Printf("Inferred verdict from image: %s\n", verdictText)
-				}
-			}
-		}
-	}
-	
-	if verdictText != "" {
-		profile.Verdict = verdictText
-	}
-	
-	// Extract description - target the profileBody div specifically
-	// First look for the profileBody div which contains the main profile content
-	profileBody := doc.Find("div#profileBody, #profileBody").First()
-	if profileBody.Length() > 0 {
-		// Get the text content of the profileBody div
-		fullText := profileBody.Text()
-		fullText = strings.TrimSpace(fullText)
-		
-		if len(fullText) > 50 {
-			// Clean up the text - remove extra whitespace and normalize line breaks
-			fullText = strings.ReplaceAll(fullText, "\r\n", "\n")
-			fullText = strings.ReplaceAll(fullText, "\r", "\n")
-			
-			// Remove any "Verdict:", "Pros:", "Cons:" sections if present at the end
-			verdictIndex := strings.LastIndex(strings.ToLower(fullText), "verdict:")
-			prosIndex := strings.LastIndex(strings.ToLower(fullText), "pros:")
-			consIndex := strings.LastIndex(strings.ToLower(fullText), "cons:")
-			
-			cutIndex := len(fullText)
-			if verdictIndex > 0 && verdictIndex < cutIndex {
-				cutIndex = verdictIndex
-			}
-			if prosIndex > 0 && prosIndex < cutIndex {
-				cutIndex = prosIndex
-			}
-			if consIndex > 0 && consIndex < cutIndex {
-				cutIndex = consIndex
-			}
-			
-			// Keep just the description part
-			if cutIndex < len(fullText) {
-				fullText = fullText[:cutIndex]
-			}
-			
-			fullText = strings.TrimSpace(fullText)
-			profile.Description = fullText
-			if verbose {
-				fmt.Printf("Extracted full description from profileBody: %d chars\n", len(fullText))
-			}
-		}
-	}
-	
-	// Fallback: look for any substantial text blocks if profileBody not found
-	if profile.Description == "" || len(profile.Description) < 50 {
-		descFound := false
-		doc.Find("td[valign=top] font, div.profile-description, p.description, td font").Each(func(i int, s *goquery.Selection) {
-			if descFound {
-				return // Already found
-			}
-			
-			// Skip if it contains verdict or pros/cons
-			text := strings.TrimSpace(s.Text())
-			lowerText := strings.ToLower(text)
-			
-			if !strings.Contains(lowerText, "verdict:") && 
-			   !strings.Contains(lowerText, "pros:") && 
-			   !strings.Contains(lowerText, "cons:") && 
-			   len(text) > 100 {
-				profile.Description = text
-				if verbose {
-					fmt.Printf("Extracted description from alternate source: %d chars\n", len(text))
-				}
-				descFound = true
-			}
-		})
-	}
-	
-	// If still no substantial description found, try the meta description as a last resort
-	if profile.Description == "" || len(profile.Description) < 30 {
-		metaDesc, exists := doc.Find("meta[name=description]").Attr("content")
-		if exists && metaDesc != "" && len(metaDesc) > 10 {
-			// Skip the "JewOrNotJew.com: " prefix if present
-			if strings.HasPrefix(metaDesc, "JewOrNotJew.com:") {
-				metaDesc = strings.TrimPrefix(metaDesc, "JewOrNotJew.com:")
-				metaDesc = strings.TrimSpace(metaDesc)
-			}
-			profile.Description = metaDesc
-			if verbose {
-				fmt.Printf("Using meta description as fallback: %s\n", metaDesc)
-			}
-		}
-	}
-	
-	// Let's also try to extract the main description from table cells,
-	// as the site structure might vary
-	if profile.Description == "" || len(profile.Description) < 100 {
-		// Look for the largest text block in the page that's not pros/cons/verdict
-		var largestText string
-		doc.Find("table td").Each(func(i int, s *goquery.Selection) {
-			text := strings.TrimSpace(s.Text())
-			lcText := strings.ToLower(text)
-			
-			// Skip sections that are clearly not the main description
-			if !strings.Contains(lcText, "verdict:") && 
-			   !strings.Contains(lcText, "pros:") && 
-			   !strings.Contains(lcText, "cons:") && 
-			   len(text) > len(largestText) {
-				largestText = text
-			}
-		})
-		
-		if len(largestText) > 100 {
-			profile.Description = largestText
-			if verbose {
-				fmt.Printf("Extracted description from largest table cell: %d chars\n", len(largestText))
-			}
-		}
-	}
-	
-	// Extract pros and cons - more comprehensive approach
-	// First look for dedicated pros/cons sections
-	var prosFound, consFound bool
-	
-	// Try to extract from the complete HTML content
-	htmlString, err := doc.Html()
-	if err == nil {
-		// Check for pros section with regex pattern matching
-		prosRegex := regexp.MustCompile(`(?i)(?:Pros|PROS|Pros:)[\s\n]*(.*?)(?:Cons|CONS|Cons:|$)`)
-		prosMatches := prosRegex.FindStringSubmatch(htmlString)
-		if len(prosMatches) > 1 {
-			prosContent := prosMatches[1]
-			pros := splitByBullets(prosContent)
-			for _, pro := range pros {
-				pro = strings.TrimSpace(pro)
-				// Filter out invalid entries
-				if pro != "" && len(pro) > 3 && !strings.Contains(strings.ToLower(pro), "cons:") {
-					profile.Pros = append(profile.Pros, pro)
-					if verbose {
-						fmt.Printf("Extracted pro from regex: %s\n", pro)
-					}
-					prosFound = true
-				}
-			}
-		}
-		
-		// Check for cons section with regex pattern matching - more careful approach
-		consRegex := regexp.MustCompile(`(?i)(?:Cons|CONS|Cons:)[\s\n]*([^:]*)(?:\s*Verdict:|$)`)
-		consMatches := consRegex.FindStringSubmatch(htmlString)
-		if len(consMatches) > 1 {
-			consContent := consMatches[1]
-			// Check if the cons content is reasonable (not just a fragment)
-			if len(consContent) > 10 && len(consContent) < 1000 {
-				cons := splitByBullets(consContent)
-				for _, con := range cons {
-					con = strings.TrimSpace(con)
-					// Filter out invalid entries and fragments
-					if con != "" && len(con) > 10 && !strings.Contains(con, "idered") {
-						// Skip if HTML entities are found, suggesting invalid content
-						if !strings.Contains(con, "&#") && !strings.Contains(con, "&lt;") && !strings.Contains(con, "&gt;") && !strings.Contains(con, "<span") {
-							profile.Cons = append(profile.Cons, con)
-							if verbose {
-								fmt.Printf("Extracted con from regex: %s\n", con)
-							}
-							consFound = true
-						}
-					}
-				}
-			}
-		}
-	}
-	
-	// If regex didn't find anything, try DOM-based extraction
-	if !prosFound || !consFound {
-		// Try to find specific pros/cons sections
-		doc.Find("div, td, span, p, font").Each(func(i int, s *goquery.Selection) {
-			text := strings.TrimSpace(s.Text())
-			lowerText := strings.ToLower(text)
-			
-			// Look for pros section
-			if !prosFound && (strings.Contains(lowerText, "pros:") || strings.HasPrefix(lowerText, "pros")) {
-				// Extract pros
-				parts := strings.SplitN(text, ":", 2)
-				var prosList string
-				if len(parts) > 1 {
-					prosList = parts[1]
-				} else {
-					// Try taking everything after "Pros"
-					prosList = strings.TrimPrefix(text, "Pros")
-				}
-				
-				// Split by bullet points or line breaks
-				pros := splitByBullets(prosList)
-				for _, pro := range pros {
-					pro = strings.TrimSpace(pro)
-					if pro != "" && len(pro) > 3 && !strings.Contains(strings.ToLower(pro), "cons") {
-						profile.Pros = append(profile.Pros, pro)
-						if verbose {
-							fmt.Printf("Extracted pro from DOM: %s\n", pro)
-						}
-						prosFound = true
-					}
-				}
-			}
-			
-			// Look for cons section
-			if !consFound && (strings.Contains(lowerText, "cons:") || strings.HasPrefix(lowerText, "cons")) {
-				// Extract cons
-				parts := strings.SplitN(text, ":", 2)
-				var consList string
-				if len(parts) > 1 {
-					consList = parts[1]
-				} else {
-					// Try taking everything after "Cons"
-					consList = strings.TrimPrefix(text, "Cons")
-				}
-				
-				// Split by bullet points or line breaks
-				cons := splitByBullets(consList)
-				for _, con := range cons {
-					con = strings.TrimSpace(con)
-					if con != "" && len(con) > 3 {
-						// Skip if HTML entities are found, suggesting invalid content
-						if !strings.Contains(con, "&#") && !strings.Contains(con, "&lt;") && !strings.Contains(con, "&gt;") && !strings.Contains(con, "<span") {
-							profile.Cons = append(profile.Cons, con)
-							if verbose {
-								fmt.Printf("Extracted con from DOM: %s\n", con)
-							}
-							consFound = true
-						}
-					}
-				}
-			}
-		})
-	}
-	
-	// Also look for list items as possible pros/cons
-	doc.Find("li, ul li").Each(func(i int, s *goquery.Selection) {
-		text := strings.TrimSpace(s.Text())
-		if text != "" && len(text) > 3 {
-			// Try to determine if this is a pro or con based on context
-			parent := s.ParentsFiltered("div, td, ul").First()
-			parentText := strings.ToLower(parent.Text())
-			
-			if strings.Contains(parentText, "pros") && !strings.Contains(strings.ToLower(text), "cons:") {
-				// Likely a pro
-				if !contains(profile.Pros, text) {
-					profile.Pros = append(profile.Pros, text)
-					if verbose {
-						fmt.Printf("Extracted pro from list: %s\n", text)
-					}
-				}
-			} else if strings.Contains(parentText, "cons") {
-				// Likely a con
-				// Skip if HTML entities are found, suggesting invalid content
-				if !contains(profile.Cons, text) && 
-				   !strings.Contains(text, "&#") && 
-				   !strings.Contains(text, "&lt;") && 
-				   !strings.Contains(text, "&gt;") && 
-				   !strings.Contains(text, "<span") {
-					profile.Cons = append(profile.Cons, text)
-					if verbose {
-						fmt.Printf("Extracted con from list: %s\n", text)
-					}
-				}
-			}
-		}
-	})
-	
-	// Extract category if available - improved approach
-	doc.Find("td font, span, div, p, strong, b, h3").Each(func(i int, s *goquery.Selection) {
-		text := strings.TrimSpace(s.Text())
-		// Look for explicit category marker
-		if strings.Contains(text, "Category:") {
-			parts := strings.SplitN(text, "Category:", 2)
-			if len(parts) > 1 {
-				category := strings.TrimSpace(parts[1])
-				// Clean up the category
-				category = cleanHTML(category)
-				category = strings.Trim(category, ".")
-				
-				if category != "" {
-					profile.Category = category
-					if verbose {
-						fmt.Printf("Extracted category: %s\n", category)
-					}
-				}
-			}
-		} else if strings.HasPrefix(text, "Category") {
-			// Try alternate format
-			parts := strings.SplitN(text, " ", 2)
-			if len(parts) > 1 {
-				category := strings.TrimSpace(parts[1])
-				category = cleanHTML(category)
-				category = strings.Trim(category, ".")
-				
-				if category != "" {
-					profile.Category = category
-					if verbose {
-						fmt.Printf("Extracted category from alternate format: %s\n", category)
-					}
-				}
-			}
-		}
-	})
-	
-	// If no category found, try to infer from keywords, meta tags, or page content
-	if profile.Category == "" {
-		// First try keywords meta tag
-		keywords, exists := doc.Find("meta[name=keywords]").Attr("content")
-		if exists && keywords != "" {
-			keywordsList := strings.Split(keywords, ",")
-			for _, keyword := range keywordsList {
-				keyword = strings.TrimSpace(keyword)
-				// Check common categories
-				for _, cat := range []string{"Actor", "Actress", "Entertainment", "Politics", "Sports", "Music", "Science", "Business", "Religion", "History", 
-					"Art", "Literature", "Media", "Academia", "Military", "Fashion", "Technology", "Comedy", "Royalty", "Film", "Television"} {
-					if strings.Contains(strings.ToLower(keyword), strings.ToLower(cat)) {
-						profile.Category = cat
-						if verbose {
-							fmt.Printf("Inferred category from keywords: %s\n", cat)
-						}
-						break
-					}
-				}
-				if profile.Category != "" {
-					break
-				}
-			}
-		}
-		
-		// If still no category, try description text for clues
-		if profile.Category == "" && profile.Description != "" {
-			lowerDesc := strings.ToLower(profile.Description)
-			// Common category indicators in text
-			categoryClues := map[string]string{
-				"actor":        "Entertainment",
-				"actress":      "Entertainment",
-				"movie":        "Entertainment",
-				"film":         "Entertainment",
-				"directed":     "Entertainment",
-				"singer":       "Music",
-				"musician":     "Music",
-				"album":        "Music",
-				"song":         "Music",
-				"band":         "Music",
-				"political":    "Politics",
-				"politician":   "Politics",
-				"president":    "Politics",
-				"senator":      "Politics",
-				"parliament":   "Politics",
-				"scientist":    "Science",
-				"researcher":   "Science",
-				"professor":    "Academia",
-				"author":       "Literature",
-				"writer":       "Literature",
-				"book":         "Literature",
-				"athlete":      "Sports",
-				"player":       "Sports",
-				"baseball":     "Sports",
-				"football":     "Sports",
-				"basketball":   "Sports",
-				"soccer":       "Sports",
-				"tennis":       "Sports",
-				"religious":    "Religion",
-				"rabbi":        "Religion",
-				"priest":       "Religion",
-				"businessman":  "Business",
-				"entrepreneur": "Business",
-				"company":      "Business",
-				"CEO":          "Business",
-				"comedian":     "Comedy",
-				"comedy":       "Comedy",
-			}
-			
-			// Check for category clues in description
-			for clue, category := range categoryClues {
-				if strings.Contains(lowerDesc, clue) {
-					profile.Category = category
-					if verbose {
-						fmt.Printf("Inferred category from description text: %s\n", category)
-					}
-					break
-				}
-			}
-		}
-	}
-	
-	// Extract image URL - check multiple locations
-	
-	// First check for og:image or similar meta tags
-	ogImage, exists := doc.Find("meta[property='og:image']").Attr("content")
-	if exists && ogImage != "" {
-		if !strings.HasPrefix(ogImage, "http") {
-			if !strings.HasPrefix(ogImage, "/") {
-				profile.ImageURL = c.baseURL + "/" + ogImage
-			} else {
-				profile.ImageURL = c.baseURL + ogImage
-			}
-		} else {
-			profile.ImageURL = ogImage
-		}
-		if verbose {
-			fmt.Printf("Extracted image URL from meta: %s\n", profile.ImageURL)
-		}
-	}
-	
-	// If no og:image, check for image_src link
-	if profile.ImageURL == "" {
-		imageSrc, exists := doc.Find("link[rel='image_src']").Attr("href")
-		if exists && imageSrc != "" {
-			if !strings.HasPrefix(imageSrc, "http") {
-				if !strings.HasPrefix(imageSrc, "/") {
-					profile.ImageURL = c.baseURL + "/" + imageSrc
-				} else {
-					profile.ImageURL = c.baseURL + imageSrc
-				}
-			} else {
-				profile.ImageURL = imageSrc
-			}
-			if verbose {
-				fmt.Printf("Extracted image URL from link: %s\n", profile.ImageURL)
-			}
-		}
-	}
-	
-	// If still no image, look for img tags
-	if profile.ImageURL == "" {
-		doc.Find("img").Each(func(i int, s *goquery.Selection) {
-			if profile.ImageURL != "" {
-				return // Already found an image
-			}
-			
-			if src, exists := s.Attr("src"); exists && src != "" {
-				// Check if it's a profile image
-				if strings.Contains(strings.ToLower(src), "people") || 
-				   strings.Contains(strings.ToLower(src), "img") || 
-				   strings.Contains(strings.ToLower(src), "images") {
-					if !strings.HasPrefix(src, "http") {
-						if strings.HasPrefix(src, "/") {
-							profile.ImageURL = c.baseURL + src
-						} else {
-							profile.ImageURL = c.baseURL + "/" + src
-						}
-					} else {
-						profile.ImageURL = src
-					}
-					if verbose {
-						fmt.Printf("Extracted image URL from img tag: %s\n", profile.ImageURL)
-					}
-				}
-			}
-		})
-	}
-	
-	return profile
-}
-
-// cleanHTML removes HTML tags and normalizes whitespace
-func cleanHTML(input string) string {
-	// Remove HTML tags
-	tagRegex := regexp.MustCompile(`<[^>]*>`)
-	withoutTags := tagRegex.ReplaceAllString(input, "")
-	
-	// Normalize whitespace
-	withoutTags = strings.ReplaceAll(withoutTags, "&nbsp;", " ")
-	withoutTags = strings.ReplaceAll(withoutTags, "\r\n", " ")
-	withoutTags = strings.ReplaceAll(withoutTags, "\n", " ")
-	
-	// Replace HTML entities
-	withoutTags = strings.ReplaceAll(withoutTags, "&amp;", "&")
-	withoutTags = strings.ReplaceAll(withoutTags, "&lt;", "<")
-	withoutTags = strings.ReplaceAll(withoutTags, "&gt;", ">")
-	withoutTags = strings.ReplaceAll(withoutTags, "&quot;", "\"")
-	withoutTags = strings.ReplaceAll(withoutTags, "&#39;", "'")
-	withoutTags = strings.ReplaceAll(withoutTags, "&#34;", "\"")
-	
-	// Collapse multiple spaces into one
-	spaceRegex := regexp.MustCompile(`\s+`)
-	withoutTags = spaceRegex.ReplaceAllString(withoutTags, " ")
-	
-	return strings.TrimSpace(withoutTags)
-}
-
 // min returns the smaller of two integers
 func min(a, b int) int {
 	if a < b {
@@ -1011,139 +875,102 @@ func min(a, b int) int {
 	return b
 }
 
-// contains checks if a string is present in a slice
-func contains(slice []string, str string) bool {
-	for _, item := range slice {
-		if item == str {
-			return true
-		}
+// resolveImageURL makes profile.ImageURL absolute against baseURL if the
+// adapter's rules returned a site-relative path.
+func resolveImageURL(baseURL string, profile *models.Profile) {
+	if profile.ImageURL == "" || strings.HasPrefix(profile.ImageURL, "http") {
+		return
+	}
+	if strings.HasPrefix(profile.ImageURL, "/") {
+		profile.ImageURL = baseURL + profile.ImageURL
+	} else {
+		profile.ImageURL = baseURL + "/" + profile.ImageURL
 	}
-	return false
 }
 
-// splitByBullets splits text by bullet points or line breaks
-func splitByBullets(text string) []string {
-	// Check for various types of bullets and split by them
-	text = strings.TrimSpace(text)
-	var items []string
-	
-	// First try to split by common bullet characters with more intelligence
-	bullets := []string{"•", "-", "★", "✓", "✔", "*", "→", "⇒", "⟹", "⇾", "⟶"}
-	
-	// Check if any bullet character is present and handle each one appropriately
-	hasBullets := false
-	for _, bullet := range bullets {
-		if strings.Contains(text, bullet) {
-			hasBullets = true
-			// Split by bullet and handle each chunk
-			parts := strings.Split(text, bullet)
-			for _, part := range parts {
-				part = strings.TrimSpace(part)
-				if part != "" {
-					items = append(items, part)
-				}
-			}
-			break
-		}
+// hydrateImage downloads profile.ImageURL via pkg/assets, if set and not
+// already hydrated, and fills in ImageLocal/ImageMIME/ImageSHA256/
+// Thumbnails. Failures are non-fatal and only logged: a broken or
+// unreachable image link shouldn't stop the rest of the profile from being
+// saved.
+func (c *Client) hydrateImage(profile *models.Profile) {
+	if profile.ImageURL == "" || profile.ImageLocal != "" {
+		return
 	}
-	
-	// If no bullets found, try splitting by newlines with better handling
-	if !hasBullets && strings.Contains(text, "\n") {
-		lines := strings.Split(text, "\n")
-		for _, line := range lines {
-			line = strings.TrimSpace(line)
-			// Check if line starts with a bullet point we didn't catch
-			for _, bullet := range bullets {
-				if strings.HasPrefix(line, bullet) {
-					line = strings.TrimSpace(strings.TrimPrefix(line, bullet))
-					break
-				}
-			}
-			
-			// Only add non-empty lines
-			if line != "" && len(line) > 2 {
-				items = append(items, line)
-			}
-		}
-	}
-	
-	// If no newlines or bullets, check for numbered points with better regex
-	if len(items) == 0 {
-		numberRegex := regexp.MustCompile(`(\d+\.\s+)`)
-		if numberRegex.MatchString(text) {
-			// Split by numbered bullets with more accuracy
-			parts := numberRegex.Split(text, -1)
-			for _, part := range parts {
-				part = strings.TrimSpace(part)
-				if part != "" && len(part) > 2 {
-					items = append(items, part)
-				}
-			}
-		}
+
+	result, err := assets.Fetch(context.Background(), c.httpClient, c.politeness, c.dataDir, profile.ImageURL)
+	if err != nil {
+		fmt.Printf("⚠️ Warning: failed to fetch image for %s: %v\n", profile.Name, err)
+		return
 	}
-	
-	// If no structure was found and the text is long enough, try using periods/semicolons
-	if len(items) == 0 && len(text) > 15 && (strings.Contains(text, ". ") || strings.Contains(text, "; ")) {
-		// Try to split by sentences if it looks like a sentence list
-		parts := strings.Split(text, ". ")
-		if len(parts) > 1 {
-			for _, part := range parts {
-				part = strings.TrimSpace(part)
-				// Make sure it's not just a fragment
-				if part != "" && len(part) > 10 {
-					// Add period back if it looks like a sentence
-					if len(part) > 20 && part[0] >= 'A' && part[0] <= 'Z' {
-						part += "."
-					}
-					items = append(items, part)
-				}
-			}
-		} else {
-			// Try semicolons as separators
-			parts = strings.Split(text, "; ")
-			for _, part := range parts {
-				part = strings.TrimSpace(part)
-				if part != "" && len(part) > 5 {
-					items = append(items, part)
-				}
-			}
+
+	profile.ImageLocal = result.LocalPath
+	profile.ImageMIME = result.MIME
+	profile.ImageSHA256 = result.SHA256
+	profile.Thumbnails = result.Thumbnails
+}
+
+// RehydrateImages backfills ImageLocal/ImageMIME/ImageSHA256/Thumbnails for
+// every loaded profile that has an ImageURL but hasn't been hydrated yet
+// (e.g. profiles saved before pkg/assets existed). Work is spread across a
+// bounded worker pool; per-host pacing is still governed by c.politeness,
+// so a large backfill doesn't hammer the source site any harder than a
+// normal scrape would.
+func (c *Client) RehydrateImages(ctx context.Context) error {
+	const concurrency = 10
+
+	c.mu.Lock()
+	pending := make([]*models.Profile, 0, len(c.profiles))
+	for _, profile := range c.profiles {
+		if profile.ImageURL != "" && profile.ImageLocal == "" {
+			pending = append(pending, profile)
 		}
 	}
-	
-	// If nothing works, just return the whole text as one item
-	if len(items) == 0 {
-		items = append(items, text)
-	}
-	
-	// Final cleanup to remove any empty items or duplicates
-	var cleanItems []string
-	seen := make(map[string]bool)
-	
-	for _, item := range items {
-		item = strings.TrimSpace(item)
-		// Skip empty or very short items
-		if item == "" || len(item) < 3 {
-			continue
-		}
-		
-		// Skip if we've already seen this
-		if seen[item] {
-			continue
+	c.mu.Unlock()
+
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for _, profile := range pending {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		default:
 		}
-		
-		seen[item] = true
-		cleanItems = append(cleanItems, item)
+
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func(profile *models.Profile) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			c.hydrateImage(profile)
+			if err := c.saveProfileToJSON(profile); err != nil {
+				fmt.Printf("⚠️ Warning: failed to save rehydrated profile %s: %v\n", profile.Name, err)
+			}
+		}(profile)
 	}
-	
-	return cleanItems
+
+	wg.Wait()
+	return nil
 }
 
-// saveProfileToJSON saves a profile to a JSON file
+// saveProfileToJSON saves a profile to a JSON file, or through c.store if
+// one was set via WithStore.
 func (c *Client) saveProfileToJSON(profile *models.Profile) error {
 	if profile == nil || profile.Name == "" {
 		return fmt.Errorf("cannot save nil or unnamed profile")
 	}
 
+	c.hydrateImage(profile)
+
+	if c.store != nil {
+		return c.store.Put(profile)
+	}
+
 	// Create safe filename from profile name
 	safeName := url.PathEscape(profile.Name)
 	if safeName == "" {
@@ -1194,6 +1021,7 @@ func (c *Client) GetProfile(name string) (*models.Profile, error) {
 func (c *Client) AddProfile(profile *models.Profile) {
 	if profile != nil && profile.Name != "" {
 		c.profiles[profile.Name] = profile
+		c.searchIndex.Add(profile)
 	}
 }
 
@@ -1202,6 +1030,12 @@ func (c *Client) SaveProfileToJSON(profile *models.Profile) error {
 	return c.saveProfileToJSON(profile)
 }
 
+// DataDir returns the directory profile JSON, images, and the search index
+// are stored under.
+func (c *Client) DataDir() string {
+	return c.dataDir
+}
+
 // ListProfiles returns all profiles
 func (c *Client) ListProfiles() []*models.Profile {
 	profiles := make([]*models.Profile, 0, len(c.profiles))
@@ -1211,37 +1045,93 @@ func (c *Client) ListProfiles() []*models.Profile {
 	return profiles
 }
 
-// LoadFromDisk loads profiles from JSON files in the data directory
+// LoadFromDisk loads profiles from JSON files in the data directory, or from
+// c.store if one was set via WithStore.
 func (c *Client) LoadFromDisk() error {
-	files, err := os.ReadDir(c.dataDir)
-	if err != nil {
-		return fmt.Errorf("failed to read data directory: %w", err)
-	}
-
-	for _, file := range files {
-		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
-			continue
+	if c.store != nil {
+		profiles, err := c.store.List(store.Filter{})
+		if err != nil {
+			return fmt.Errorf("failed to list profiles from store: %w", err)
 		}
-
-		filePath := filepath.Join(c.dataDir, file.Name())
-		data, err := os.ReadFile(filePath)
+		for _, profile := range profiles {
+			c.profiles[profile.Name] = profile
+		}
+	} else {
+		files, err := os.ReadDir(c.dataDir)
 		if err != nil {
-			return fmt.Errorf("failed to read file %s: %w", filePath, err)
+			return fmt.Errorf("failed to read data directory: %w", err)
 		}
 
-		var profile models.Profile
-		if err := json.Unmarshal(data, &profile); err != nil {
-			return fmt.Errorf("failed to unmarshal profile from %s: %w", filePath, err)
-		}
+		for _, file := range files {
+			if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+				continue
+			}
+
+			filePath := filepath.Join(c.dataDir, file.Name())
+			data, err := os.ReadFile(filePath)
+			if err != nil {
+				return fmt.Errorf("failed to read file %s: %w", filePath, err)
+			}
+
+			var profile models.Profile
+			if err := json.Unmarshal(data, &profile); err != nil {
+				return fmt.Errorf("failed to unmarshal profile from %s: %w", filePath, err)
+			}
 
-		c.profiles[profile.Name] = &profile
+			c.profiles[profile.Name] = &profile
+		}
 	}
 
+	c.rebuildDerivedState()
+
 	return nil
 }
 
-// SearchProfiles searches profiles by name or description
+// rebuildDerivedState rebuilds c.searchIndex and c.classifier from the
+// current in-memory profile set. The search index is opportunistically
+// persisted to "<dataDir>/search.idx", so a future process can load the
+// prebuilt index (see index.Load) instead of retokenizing the whole corpus;
+// save failures there are non-fatal, since the in-memory index is
+// authoritative either way.
+func (c *Client) rebuildDerivedState() {
+	profiles := make([]*models.Profile, 0, len(c.profiles))
+	for _, profile := range c.profiles {
+		profiles = append(profiles, profile)
+	}
+	c.searchIndex = index.Build(profiles)
+	_ = c.searchIndex.Save(filepath.Join(c.dataDir, "search.idx"))
+	c.classifier = classify.Train(profiles)
+}
+
+// Reclassify runs profile through the category classifier trained on
+// LoadFromDisk (see pkg/classify), returning its predicted category and
+// confidence. It does not mutate profile; callers that want to keep the
+// result set profile.Category/CategoryConfidence and call
+// SaveProfileToJSON themselves (see the CLI's "reclassify" command).
+func (c *Client) Reclassify(profile *models.Profile) (string, float64) {
+	return c.classifier.Categorize(profile)
+}
+
+// Search runs query against the in-memory BM25 index built over name,
+// description, category, verdict, and pros/cons (see pkg/index for
+// supported query syntax: "quoted phrases", field:value filters, and
+// trailing-* prefixes), returning up to limit results ordered by score.
+// Unlike SearchProfiles, this does not delegate to c.store and always
+// scores against the client's own index.
+func (c *Client) Search(query string, limit int) []index.SearchResult {
+	return c.searchIndex.Search(query, limit)
+}
+
+// SearchProfiles searches profiles by name or description, delegating to
+// c.store's Search when one was set via WithStore.
 func (c *Client) SearchProfiles(query string) []*models.Profile {
+	if c.store != nil {
+		results, err := c.store.Search(query)
+		if err == nil {
+			return results
+		}
+	}
+
 	var results []*models.Profile
 	queryLower := strings.ToLower(query)
 
@@ -0,0 +1,243 @@
+// Redis caching decorator for Store. CachedStore wraps another Store and
+// is itself a Store, so cmd/api can opt into caching with no changes to
+// pkg/api - the same decorator shape politeness.Policy uses to wrap an
+// *http.Client.
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ Store = (*CachedStore)(nil)
+
+// CachedStore caches GetProfile, ListProfiles, and SearchProfiles results
+// in Redis, keyed by a hash of the query, and invalidates the cache on
+// every write. Reads and writes it doesn't specifically cache or
+// invalidate (SearchProfilesFTS, QueryProfilesFiltered, InitSchema, and the
+// webmention methods) pass straight through to the embedded Store.
+type CachedStore struct {
+	Store
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+// NewCachedStore wraps inner with a Redis cache at redisAddr, caching
+// reads for ttl. It pings Redis before returning, so callers can fall back
+// to the uncached inner Store cleanly when Redis is unreachable instead of
+// discovering it on the first request.
+func NewCachedStore(inner Store, redisAddr string, ttl time.Duration) (*CachedStore, error) {
+	rdb := redis.NewClient(&redis.Options{Addr: redisAddr})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		rdb.Close()
+		return nil, fmt.Errorf("failed to reach redis at %s: %w", redisAddr, err)
+	}
+
+	return &CachedStore{Store: inner, rdb: rdb, ttl: ttl}, nil
+}
+
+// cacheKey hashes parts into a short, fixed-length key under prefix, so a
+// long or unusual query string (say, an FTS5 MATCH expression) can't blow
+// up Redis's key size or collide across prefixes.
+func cacheKey(prefix string, parts ...string) string {
+	h := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return prefix + ":" + hex.EncodeToString(h[:])
+}
+
+func (c *CachedStore) getCached(ctx context.Context, key string, dest interface{}) bool {
+	data, err := c.rdb.Get(ctx, key).Bytes()
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, dest) == nil
+}
+
+func (c *CachedStore) setCached(ctx context.Context, key string, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	c.rdb.Set(ctx, key, data, c.ttl)
+}
+
+// GetProfile caches per-name lookups under the "profile" prefix.
+func (c *CachedStore) GetProfile(name string) (*models.Profile, error) {
+	ctx := context.Background()
+	key := cacheKey("profile", name)
+
+	var profile models.Profile
+	if c.getCached(ctx, key, &profile) {
+		return &profile, nil
+	}
+
+	result, err := c.Store.GetProfile(name)
+	if err != nil {
+		return nil, err
+	}
+	c.setCached(ctx, key, result)
+	return result, nil
+}
+
+// ListProfiles caches the full profile list under one fixed key.
+func (c *CachedStore) ListProfiles() ([]*models.Profile, error) {
+	ctx := context.Background()
+	key := "profiles:all"
+
+	var profiles []*models.Profile
+	if c.getCached(ctx, key, &profiles) {
+		return profiles, nil
+	}
+
+	result, err := c.Store.ListProfiles()
+	if err != nil {
+		return nil, err
+	}
+	c.setCached(ctx, key, result)
+	return result, nil
+}
+
+// SearchProfiles caches results under the "search" prefix, keyed by query.
+func (c *CachedStore) SearchProfiles(query string) ([]*models.Profile, error) {
+	ctx := context.Background()
+	key := cacheKey("search", query)
+
+	var profiles []*models.Profile
+	if c.getCached(ctx, key, &profiles) {
+		return profiles, nil
+	}
+
+	result, err := c.Store.SearchProfiles(query)
+	if err != nil {
+		return nil, err
+	}
+	c.setCached(ctx, key, result)
+	return result, nil
+}
+
+// InsertProfile goes through InsertProfiles, so a single write invalidates
+// the same cache entries a bulk write does.
+func (c *CachedStore) InsertProfile(profile *models.Profile) error {
+	return c.InsertProfiles([]*models.Profile{profile}, nil)
+}
+
+// InsertProfiles delegates to the embedded Store, then invalidates every
+// cache entry the write could have made stale: the touched profiles'
+// individual entries, the full list, and every cached search (a changed
+// profile could start or stop matching any search query, so there's no
+// way to invalidate search results more narrowly than all of them).
+func (c *CachedStore) InsertProfiles(profiles []*models.Profile, onProgress ProgressFunc) error {
+	if err := c.Store.InsertProfiles(profiles, onProgress); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	keys := make([]string, 0, len(profiles)+1)
+	for _, profile := range profiles {
+		keys = append(keys, cacheKey("profile", profile.Name))
+	}
+	keys = append(keys, "profiles:all")
+	c.rdb.Del(ctx, keys...)
+	c.invalidateSearches(ctx)
+
+	return nil
+}
+
+// DeleteProfile delegates to the embedded Store, then invalidates name's
+// cache entry, the full list, and every cached search.
+func (c *CachedStore) DeleteProfile(name string) error {
+	if err := c.Store.DeleteProfile(name); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	c.rdb.Del(ctx, cacheKey("profile", name), "profiles:all")
+	c.invalidateSearches(ctx)
+
+	return nil
+}
+
+// invalidateSearches deletes every cached "search:*" entry via SCAN, which
+// walks the keyspace in small batches instead of blocking Redis the way
+// the KEYS command would.
+func (c *CachedStore) invalidateSearches(ctx context.Context) {
+	var cursor uint64
+	for {
+		keys, next, err := c.rdb.Scan(ctx, cursor, "search:*", 100).Result()
+		if err != nil {
+			return
+		}
+		if len(keys) > 0 {
+			c.rdb.Del(ctx, keys...)
+		}
+		cursor = next
+		if cursor == 0 {
+			break
+		}
+	}
+}
+
+// Close closes the Redis connection, then the embedded Store.
+func (c *CachedStore) Close() error {
+	redisErr := c.rdb.Close()
+	if err := c.Store.Close(); err != nil {
+		return err
+	}
+	return redisErr
+}
+
+// CacheStats is the keyspace-hit-rate and resource-usage snapshot CacheStats
+// returns, parsed from Redis's INFO output.
+type CacheStats struct {
+	KeyspaceHits     int64 `json:"keyspace_hits"`
+	KeyspaceMisses   int64 `json:"keyspace_misses"`
+	UsedMemoryBytes  int64 `json:"used_memory_bytes"`
+	ConnectedClients int64 `json:"connected_clients"`
+}
+
+// CacheStatser is implemented by stores that can report cache
+// effectiveness, so pkg/api's /api/stats handler can type-assert for it
+// without depending on CachedStore directly.
+type CacheStatser interface {
+	CacheStats() (*CacheStats, error)
+}
+
+var _ CacheStatser = (*CachedStore)(nil)
+
+// CacheStats parses Redis's INFO output for the stats and memory sections
+// - "key:value\r\n" lines, the same shape as the redis-info examples that
+// scrape keyspace_hits/keyspace_misses/used_memory/connected_clients.
+func (c *CachedStore) CacheStats() (*CacheStats, error) {
+	ctx := context.Background()
+	info, err := c.rdb.Info(ctx, "stats", "memory", "clients").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch redis info: %w", err)
+	}
+
+	fields := map[string]string{}
+	for _, line := range strings.Split(info, "\r\n") {
+		k, v, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		fields[k] = v
+	}
+
+	stats := &CacheStats{}
+	stats.KeyspaceHits, _ = strconv.ParseInt(fields["keyspace_hits"], 10, 64)
+	stats.KeyspaceMisses, _ = strconv.ParseInt(fields["keyspace_misses"], 10, 64)
+	stats.UsedMemoryBytes, _ = strconv.ParseInt(fields["used_memory"], 10, 64)
+	stats.ConnectedClients, _ = strconv.ParseInt(fields["connected_clients"], 10, 64)
+	return stats, nil
+}
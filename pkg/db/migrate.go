@@ -0,0 +1,233 @@
+package db
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RevertLastMigration rolls back the most recently applied migration for
+// the database at dsn (a SQLite path or a postgres:// DSN), running its
+// down.sql script and removing its schema_migrations row. It returns the
+// version it reverted, or 0 if no migrations were applied. Used by
+// cmd/migrate's "down" command; New/InitSchema never call this.
+func RevertLastMigration(dsn string) (int, error) {
+	postgres := strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://")
+
+	driver, migDir, migFS := "sqlite3", "migrations/sqlite", sqliteMigrationsFS
+	if postgres {
+		driver, migDir, migFS = "postgres", "migrations/postgres", postgresMigrationsFS
+	}
+
+	sqlDB, err := sql.Open(driver, dsn)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open database: %w", err)
+	}
+	defer sqlDB.Close()
+
+	migs, err := loadMigrations(migFS, migDir)
+	if err != nil {
+		return 0, err
+	}
+	return revertMigration(sqlDB, migs, postgres)
+}
+
+//go:embed migrations/sqlite/*.sql
+var sqliteMigrationsFS embed.FS
+
+//go:embed migrations/postgres/*.sql
+var postgresMigrationsFS embed.FS
+
+// migration is one numbered schema step, embedded as a pair of
+// NNNN_name.up.sql / NNNN_name.down.sql files.
+type migration struct {
+	version int
+	name    string
+	up      string
+	down    string
+}
+
+// loadMigrations reads every *.sql file under dir in migFS, pairs up/down
+// files sharing a version number, and returns them sorted ascending.
+func loadMigrations(migFS embed.FS, dir string) ([]migration, error) {
+	entries, err := migFS.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations dir %s: %w", dir, err)
+	}
+
+	byVersion := map[int]*migration{}
+	for _, entry := range entries {
+		version, name, direction, ok := parseMigrationFilename(entry.Name())
+		if !ok {
+			continue
+		}
+
+		data, err := migFS.ReadFile(dir + "/" + entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		m, exists := byVersion[version]
+		if !exists {
+			m = &migration{version: version, name: name}
+			byVersion[version] = m
+		}
+		switch direction {
+		case "up":
+			m.up = string(data)
+		case "down":
+			m.down = string(data)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+	return migrations, nil
+}
+
+// parseMigrationFilename splits "0002_fts_profiles.up.sql" into
+// (2, "fts_profiles", "up", true).
+func parseMigrationFilename(filename string) (version int, name, direction string, ok bool) {
+	base := strings.TrimSuffix(filename, ".sql")
+	if base == filename {
+		return 0, "", "", false
+	}
+
+	dot := strings.LastIndex(base, ".")
+	if dot < 0 {
+		return 0, "", "", false
+	}
+	direction = base[dot+1:]
+	if direction != "up" && direction != "down" {
+		return 0, "", "", false
+	}
+	base = base[:dot]
+
+	underscore := strings.Index(base, "_")
+	if underscore < 0 {
+		return 0, "", "", false
+	}
+	version, err := strconv.Atoi(base[:underscore])
+	if err != nil {
+		return 0, "", "", false
+	}
+	return version, base[underscore+1:], direction, true
+}
+
+// createTrackingTableSQL records which migrations have run. The syntax is
+// valid on both SQLite and Postgres, so both backends share it.
+const createTrackingTableSQL = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	applied_at TEXT NOT NULL
+)`
+
+// applyMigrations runs every migration in migs whose version isn't already
+// recorded in schema_migrations, in ascending order, each in its own
+// transaction. postgres selects $n placeholders for the tracking insert;
+// sqlite uses ?.
+func applyMigrations(sqlDB *sql.DB, migs []migration, postgres bool) error {
+	if _, err := sqlDB.Exec(createTrackingTableSQL); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	applied := map[int]bool{}
+	rows, err := sqlDB.Query("SELECT version FROM schema_migrations")
+	if err != nil {
+		return fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	for rows.Next() {
+		var v int
+		if err := rows.Scan(&v); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan schema_migrations row: %w", err)
+		}
+		applied[v] = true
+	}
+	rows.Close()
+
+	insertSQL := "INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)"
+	if postgres {
+		insertSQL = "INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)"
+	}
+
+	for _, m := range migs {
+		if applied[m.version] {
+			continue
+		}
+
+		tx, err := sqlDB.Begin()
+		if err != nil {
+			return fmt.Errorf("failed to begin migration %04d_%s: %w", m.version, m.name, err)
+		}
+
+		if _, err := tx.Exec(m.up); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to apply migration %04d_%s: %w", m.version, m.name, err)
+		}
+		if _, err := tx.Exec(insertSQL, m.version, m.name, time.Now().UTC().Format(time.RFC3339)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to record migration %04d_%s: %w", m.version, m.name, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit migration %04d_%s: %w", m.version, m.name, err)
+		}
+	}
+
+	return nil
+}
+
+// revertMigration runs the down script for the single highest applied
+// migration version and removes its schema_migrations row. Used by
+// cmd/migrate's "down" command; New/InitSchema never call this.
+func revertMigration(sqlDB *sql.DB, migs []migration, postgres bool) (int, error) {
+	var version int
+	if err := sqlDB.QueryRow("SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	if version == 0 {
+		return 0, nil
+	}
+
+	var target *migration
+	for i := range migs {
+		if migs[i].version == version {
+			target = &migs[i]
+			break
+		}
+	}
+	if target == nil {
+		return 0, fmt.Errorf("no migration source found for applied version %d", version)
+	}
+
+	deleteSQL := "DELETE FROM schema_migrations WHERE version = ?"
+	if postgres {
+		deleteSQL = "DELETE FROM schema_migrations WHERE version = $1"
+	}
+
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin rollback of migration %04d_%s: %w", target.version, target.name, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(target.down); err != nil {
+		return 0, fmt.Errorf("failed to revert migration %04d_%s: %w", target.version, target.name, err)
+	}
+	if _, err := tx.Exec(deleteSQL, target.version); err != nil {
+		return 0, fmt.Errorf("failed to unrecord migration %04d_%s: %w", target.version, target.name, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit rollback of migration %04d_%s: %w", target.version, target.name, err)
+	}
+
+	return target.version, nil
+}
@@ -0,0 +1,496 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+var _ Store = (*PostgresStore)(nil)
+
+// PostgresStore is the shared, production Store implementation used by
+// cmd/api when operators want a queryable backend instead of a local
+// SQLite file. Full-text search runs against a tsvector column (see
+// migrations/postgres) kept in sync by triggers, the same shape as
+// SQLiteStore's fts_profiles table.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore opens a Postgres database using dsn (a standard
+// "postgres://" connection string) and brings its schema up to date via
+// InitSchema.
+func NewPostgresStore(dsn string) (*PostgresStore, error) {
+	sqlDB, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := sqlDB.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	s := &PostgresStore{db: sqlDB}
+	if err := s.InitSchema(); err != nil {
+		sqlDB.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// InitSchema applies any embedded postgres migrations (see
+// migrations/postgres) that haven't run yet, tracked in the
+// schema_migrations table.
+func (d *PostgresStore) InitSchema() error {
+	migs, err := loadMigrations(postgresMigrationsFS, "migrations/postgres")
+	if err != nil {
+		return err
+	}
+	return applyMigrations(d.db, migs, true)
+}
+
+// InsertProfile inserts or updates a single profile. Equivalent to
+// InsertProfiles with a single profile and no progress callback.
+func (d *PostgresStore) InsertProfile(profile *models.Profile) error {
+	return d.InsertProfiles([]*models.Profile{profile}, nil)
+}
+
+// InsertProfiles bulk-inserts or updates profiles in one transaction,
+// preparing each statement once and reusing it across every row. The
+// search_vector column and its triggers (see migrations/postgres) keep the
+// full-text index in sync as each row commits. onProgress, if non-nil, is
+// called after each row.
+func (d *PostgresStore) InsertProfiles(profiles []*models.Profile, onProgress ProgressFunc) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	profileStmt, err := tx.Prepare(`
+		INSERT INTO profiles (name, url, verdict, description, score, category, image_url, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (name) DO UPDATE SET
+			url = excluded.url,
+			verdict = excluded.verdict,
+			description = excluded.description,
+			score = excluded.score,
+			category = excluded.category,
+			image_url = excluded.image_url,
+			updated_at = excluded.updated_at
+		RETURNING id
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare profile statement: %w", err)
+	}
+	defer profileStmt.Close()
+
+	prosDeleteStmt, err := tx.Prepare("DELETE FROM pros WHERE profile_id = $1")
+	if err != nil {
+		return fmt.Errorf("failed to prepare pros delete statement: %w", err)
+	}
+	defer prosDeleteStmt.Close()
+
+	consDeleteStmt, err := tx.Prepare("DELETE FROM cons WHERE profile_id = $1")
+	if err != nil {
+		return fmt.Errorf("failed to prepare cons delete statement: %w", err)
+	}
+	defer consDeleteStmt.Close()
+
+	prosInsertStmt, err := tx.Prepare("INSERT INTO pros (profile_id, text) VALUES ($1, $2)")
+	if err != nil {
+		return fmt.Errorf("failed to prepare pros insert statement: %w", err)
+	}
+	defer prosInsertStmt.Close()
+
+	consInsertStmt, err := tx.Prepare("INSERT INTO cons (profile_id, text) VALUES ($1, $2)")
+	if err != nil {
+		return fmt.Errorf("failed to prepare cons insert statement: %w", err)
+	}
+	defer consInsertStmt.Close()
+
+	for i, profile := range profiles {
+		var profileID int64
+		row := profileStmt.QueryRow(
+			profile.Name, profile.URL, profile.Verdict, profile.Description,
+			profile.Score, profile.Category, profile.ImageURL, profile.CreatedAt, profile.UpdatedAt,
+		)
+		if err := row.Scan(&profileID); err != nil {
+			return fmt.Errorf("failed to upsert profile %s: %w", profile.Name, err)
+		}
+
+		if _, err := prosDeleteStmt.Exec(profileID); err != nil {
+			return fmt.Errorf("failed to delete existing pros for %s: %w", profile.Name, err)
+		}
+		if _, err := consDeleteStmt.Exec(profileID); err != nil {
+			return fmt.Errorf("failed to delete existing cons for %s: %w", profile.Name, err)
+		}
+
+		for _, pro := range profile.Pros {
+			if _, err := prosInsertStmt.Exec(profileID, pro); err != nil {
+				return fmt.Errorf("failed to insert pro for %s: %w", profile.Name, err)
+			}
+		}
+		for _, con := range profile.Cons {
+			if _, err := consInsertStmt.Exec(profileID, con); err != nil {
+				return fmt.Errorf("failed to insert con for %s: %w", profile.Name, err)
+			}
+		}
+
+		if onProgress != nil {
+			onProgress(i+1, len(profiles))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetProfile retrieves a profile by name.
+func (d *PostgresStore) GetProfile(name string) (*models.Profile, error) {
+	var profileID int64
+	profile := &models.Profile{}
+
+	row := d.db.QueryRow(`
+		SELECT id, name, url, verdict, description, score, category, image_url, created_at, updated_at
+		FROM profiles
+		WHERE name = $1
+	`, name)
+	if err := row.Scan(
+		&profileID,
+		&profile.Name,
+		&profile.URL,
+		&profile.Verdict,
+		&profile.Description,
+		&profile.Score,
+		&profile.Category,
+		&profile.ImageURL,
+		&profile.CreatedAt,
+		&profile.UpdatedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errNotFound(name)
+		}
+		return nil, fmt.Errorf("failed to scan profile: %w", err)
+	}
+
+	if err := d.loadProsCons(profileID, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// DeleteProfile removes profile by name. Its pros and cons rows are
+// removed by the schema's ON DELETE CASCADE foreign keys.
+func (d *PostgresStore) DeleteProfile(name string) error {
+	res, err := d.db.Exec("DELETE FROM profiles WHERE name = $1", name)
+	if err != nil {
+		return fmt.Errorf("failed to delete profile: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check delete result: %w", err)
+	}
+	if affected == 0 {
+		return errNotFound(name)
+	}
+	return nil
+}
+
+// ListProfiles returns all profiles.
+func (d *PostgresStore) ListProfiles() ([]*models.Profile, error) {
+	return d.queryProfiles(`
+		SELECT id, name, url, verdict, description, score, category, image_url, created_at, updated_at
+		FROM profiles
+	`)
+}
+
+// SearchProfiles does a case-insensitive substring search over
+// name/verdict/description.
+func (d *PostgresStore) SearchProfiles(query string) ([]*models.Profile, error) {
+	pattern := "%" + query + "%"
+	return d.queryProfiles(`
+		SELECT id, name, url, verdict, description, score, category, image_url, created_at, updated_at
+		FROM profiles
+		WHERE name ILIKE $1 OR verdict ILIKE $1 OR description ILIKE $1
+	`, pattern)
+}
+
+// SearchProfilesFTS ranks profiles against the search_vector tsvector
+// column using plainto_tsquery, with ts_rank relevance and an ts_headline
+// snippet. Results are ordered by rank descending (Postgres's ts_rank is
+// higher for better matches, unlike SQLite's bm25()) and paginated with
+// limit/offset.
+func (d *PostgresStore) SearchProfilesFTS(query string, limit, offset int) ([]*FTSSearchResult, error) {
+	rows, err := d.db.Query(`
+		SELECT id, name, url, verdict, description, score, category, image_url, created_at, updated_at,
+			ts_rank(search_vector, plainto_tsquery('english', $1)) AS rank,
+			ts_headline('english', coalesce(description, ''), plainto_tsquery('english', $1),
+				'StartSel=<mark>, StopSel=</mark>, MaxFragments=1, MaxWords=10')
+		FROM profiles
+		WHERE search_vector @@ plainto_tsquery('english', $1)
+		ORDER BY rank DESC
+		LIMIT $2 OFFSET $3
+	`, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run fts query: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*FTSSearchResult
+	ids := make([]int64, 0)
+	byID := make(map[int64]*FTSSearchResult)
+	for rows.Next() {
+		var profileID int64
+		var rank float64
+		var snippet string
+		profile := &models.Profile{}
+		if err := rows.Scan(
+			&profileID,
+			&profile.Name,
+			&profile.URL,
+			&profile.Verdict,
+			&profile.Description,
+			&profile.Score,
+			&profile.Category,
+			&profile.ImageURL,
+			&profile.CreatedAt,
+			&profile.UpdatedAt,
+			&rank,
+			&snippet,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan fts result: %w", err)
+		}
+
+		result := &FTSSearchResult{Profile: profile, Rank: rank, Snippet: snippet}
+		results = append(results, result)
+		ids = append(ids, profileID)
+		byID[profileID] = result
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	pros, cons, err := d.getProsConsBatch(ids)
+	if err != nil {
+		return nil, err
+	}
+	for id, result := range byID {
+		result.Profile.Pros = pros[id]
+		result.Profile.Cons = cons[id]
+	}
+
+	return results, nil
+}
+
+// QueryProfilesFiltered returns profiles matching filter, built as a
+// single parameterized query through queryProfiles rather than
+// ListProfiles/SearchProfiles's load-everything-then-filter-in-memory
+// approach.
+func (d *PostgresStore) QueryProfilesFiltered(filter ProfileFilter) ([]*models.Profile, error) {
+	var conditions []string
+	var args []interface{}
+	addCond := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.Category != "" {
+		addCond("category = $%d", filter.Category)
+	}
+	if filter.Verdict != "" {
+		addCond("verdict = $%d", filter.Verdict)
+	}
+	if filter.ScoreGte != nil {
+		addCond("score >= $%d", *filter.ScoreGte)
+	}
+	if filter.NameContains != "" {
+		addCond("name ILIKE $%d", "%"+filter.NameContains+"%")
+	}
+
+	query := `
+		SELECT id, name, url, verdict, description, score, category, image_url, created_at, updated_at
+		FROM profiles
+	`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY " + sortColumn(filter.SortBy)
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+		if filter.Offset > 0 {
+			args = append(args, filter.Offset)
+			query += fmt.Sprintf(" OFFSET $%d", len(args))
+		}
+	}
+
+	return d.queryProfiles(query, args...)
+}
+
+// InsertWebmention records a webmention for the profile named
+// profileName, upserting on (profile_id, source_url).
+func (d *PostgresStore) InsertWebmention(profileName string, wm *models.Webmention) error {
+	var profileID int64
+	if err := d.db.QueryRow("SELECT id FROM profiles WHERE name = $1", profileName).Scan(&profileID); err != nil {
+		if err == sql.ErrNoRows {
+			return errNotFound(profileName)
+		}
+		return fmt.Errorf("failed to look up profile: %w", err)
+	}
+
+	_, err := d.db.Exec(`
+		INSERT INTO webmentions (profile_id, source_url, type, author_name, author_photo, content, published_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (profile_id, source_url) DO UPDATE SET
+			type = excluded.type,
+			author_name = excluded.author_name,
+			author_photo = excluded.author_photo,
+			content = excluded.content,
+			published_at = excluded.published_at
+	`, profileID, wm.SourceURL, wm.Type, wm.AuthorName, wm.AuthorPhoto, wm.Content, wm.PublishedAt, wm.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert webmention: %w", err)
+	}
+	return nil
+}
+
+// ListWebmentions returns every webmention recorded for the profile
+// named profileName, oldest first.
+func (d *PostgresStore) ListWebmentions(profileName string) ([]*models.Webmention, error) {
+	rows, err := d.db.Query(`
+		SELECT w.source_url, w.type, w.author_name, w.author_photo, w.content, w.published_at, w.created_at
+		FROM webmentions w
+		JOIN profiles p ON p.id = w.profile_id
+		WHERE p.name = $1
+		ORDER BY w.created_at ASC
+	`, profileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webmentions: %w", err)
+	}
+	defer rows.Close()
+
+	var mentions []*models.Webmention
+	for rows.Next() {
+		wm := &models.Webmention{}
+		if err := rows.Scan(&wm.SourceURL, &wm.Type, &wm.AuthorName, &wm.AuthorPhoto, &wm.Content, &wm.PublishedAt, &wm.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webmention: %w", err)
+		}
+		mentions = append(mentions, wm)
+	}
+	return mentions, nil
+}
+
+// Close closes the underlying database connection.
+func (d *PostgresStore) Close() error {
+	return d.db.Close()
+}
+
+func (d *PostgresStore) queryProfiles(query string, args ...interface{}) ([]*models.Profile, error) {
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query profiles: %w", err)
+	}
+	defer rows.Close()
+
+	profiles := []*models.Profile{}
+	profileIDs := map[int64]*models.Profile{}
+	for rows.Next() {
+		profile := &models.Profile{}
+		var id int64
+		if err := rows.Scan(
+			&id,
+			&profile.Name,
+			&profile.URL,
+			&profile.Verdict,
+			&profile.Description,
+			&profile.Score,
+			&profile.Category,
+			&profile.ImageURL,
+			&profile.CreatedAt,
+			&profile.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan profile: %w", err)
+		}
+		profiles = append(profiles, profile)
+		profileIDs[id] = profile
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(profileIDs))
+	for id := range profileIDs {
+		ids = append(ids, id)
+	}
+	pros, cons, err := d.getProsConsBatch(ids)
+	if err != nil {
+		return nil, err
+	}
+	for id, profile := range profileIDs {
+		profile.Pros = pros[id]
+		profile.Cons = cons[id]
+	}
+	return profiles, nil
+}
+
+// getProsConsBatch loads the pros and cons for every id in profileIDs with
+// one query each (using = ANY($1) rather than one query per id), so
+// queryProfiles and SearchProfilesFTS issue two pros/cons queries in total
+// rather than two per returned profile.
+func (d *PostgresStore) getProsConsBatch(profileIDs []int64) (pros, cons map[int64][]string, err error) {
+	pros = make(map[int64][]string, len(profileIDs))
+	cons = make(map[int64][]string, len(profileIDs))
+	if len(profileIDs) == 0 {
+		return pros, cons, nil
+	}
+
+	prosRows, err := d.db.Query("SELECT profile_id, text FROM pros WHERE profile_id = ANY($1)", pq.Array(profileIDs))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query pros: %w", err)
+	}
+	for prosRows.Next() {
+		var id int64
+		var text string
+		if err := prosRows.Scan(&id, &text); err != nil {
+			prosRows.Close()
+			return nil, nil, fmt.Errorf("failed to scan pro: %w", err)
+		}
+		pros[id] = append(pros[id], text)
+	}
+	prosRows.Close()
+
+	consRows, err := d.db.Query("SELECT profile_id, text FROM cons WHERE profile_id = ANY($1)", pq.Array(profileIDs))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query cons: %w", err)
+	}
+	for consRows.Next() {
+		var id int64
+		var text string
+		if err := consRows.Scan(&id, &text); err != nil {
+			consRows.Close()
+			return nil, nil, fmt.Errorf("failed to scan con: %w", err)
+		}
+		cons[id] = append(cons[id], text)
+	}
+	consRows.Close()
+
+	return pros, cons, nil
+}
+
+// loadProsCons loads the pros and cons for a single profile. It's a thin
+// wrapper around getProsConsBatch for call sites (GetProfile) that only
+// ever need one profile's worth.
+func (d *PostgresStore) loadProsCons(profileID int64, profile *models.Profile) error {
+	pros, cons, err := d.getProsConsBatch([]int64{profileID})
+	if err != nil {
+		return err
+	}
+	profile.Pros = pros[profileID]
+	profile.Cons = cons[profileID]
+	return nil
+}
@@ -0,0 +1,541 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+var _ Store = (*SQLiteStore)(nil)
+
+// SQLiteStore is the local, file-backed Store implementation used by the
+// scraper and CLI. Its fts_profiles FTS5 virtual table (see
+// migrations/sqlite) is kept in sync by triggers, so SearchProfilesFTS
+// never has to rebuild an index.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore opens (creating if necessary) a SQLite database at path
+// and brings its schema up to date via InitSchema.
+func NewSQLiteStore(path string) (*SQLiteStore, error) {
+	sqlDB, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := sqlDB.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	s := &SQLiteStore{db: sqlDB}
+	if err := s.InitSchema(); err != nil {
+		sqlDB.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// InitSchema applies any embedded sqlite migrations (see migrations/sqlite)
+// that haven't run yet, tracked in the schema_migrations table.
+func (d *SQLiteStore) InitSchema() error {
+	migs, err := loadMigrations(sqliteMigrationsFS, "migrations/sqlite")
+	if err != nil {
+		return err
+	}
+	return applyMigrations(d.db, migs, false)
+}
+
+// InsertProfile inserts or updates a single profile.
+func (d *SQLiteStore) InsertProfile(profile *models.Profile) error {
+	return d.InsertProfiles([]*models.Profile{profile}, nil)
+}
+
+// InsertProfiles bulk-inserts or updates profiles in one transaction,
+// preparing each statement once and reusing it across every row rather
+// than paying a fresh prepare (and, on commit, an fsync) per profile.
+func (d *SQLiteStore) InsertProfiles(profiles []*models.Profile, onProgress ProgressFunc) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	profileStmt, err := tx.Prepare(`
+		INSERT INTO profiles (name, url, verdict, description, score, category, image_url, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET
+			url = excluded.url,
+			verdict = excluded.verdict,
+			description = excluded.description,
+			score = excluded.score,
+			category = excluded.category,
+			image_url = excluded.image_url,
+			updated_at = excluded.updated_at
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare profile statement: %w", err)
+	}
+	defer profileStmt.Close()
+
+	idStmt, err := tx.Prepare("SELECT id FROM profiles WHERE name = ?")
+	if err != nil {
+		return fmt.Errorf("failed to prepare id lookup statement: %w", err)
+	}
+	defer idStmt.Close()
+
+	prosDeleteStmt, err := tx.Prepare("DELETE FROM pros WHERE profile_id = ?")
+	if err != nil {
+		return fmt.Errorf("failed to prepare pros delete statement: %w", err)
+	}
+	defer prosDeleteStmt.Close()
+
+	consDeleteStmt, err := tx.Prepare("DELETE FROM cons WHERE profile_id = ?")
+	if err != nil {
+		return fmt.Errorf("failed to prepare cons delete statement: %w", err)
+	}
+	defer consDeleteStmt.Close()
+
+	prosInsertStmt, err := tx.Prepare("INSERT INTO pros (profile_id, text) VALUES (?, ?)")
+	if err != nil {
+		return fmt.Errorf("failed to prepare pros insert statement: %w", err)
+	}
+	defer prosInsertStmt.Close()
+
+	consInsertStmt, err := tx.Prepare("INSERT INTO cons (profile_id, text) VALUES (?, ?)")
+	if err != nil {
+		return fmt.Errorf("failed to prepare cons insert statement: %w", err)
+	}
+	defer consInsertStmt.Close()
+
+	for i, profile := range profiles {
+		if _, err := profileStmt.Exec(
+			profile.Name,
+			profile.URL,
+			profile.Verdict,
+			profile.Description,
+			profile.Score,
+			profile.Category,
+			profile.ImageURL,
+			profile.CreatedAt,
+			profile.UpdatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to insert profile %s: %w", profile.Name, err)
+		}
+
+		var profileID int64
+		if err := idStmt.QueryRow(profile.Name).Scan(&profileID); err != nil {
+			return fmt.Errorf("failed to get profile ID for %s: %w", profile.Name, err)
+		}
+
+		if _, err := prosDeleteStmt.Exec(profileID); err != nil {
+			return fmt.Errorf("failed to delete existing pros for %s: %w", profile.Name, err)
+		}
+		if _, err := consDeleteStmt.Exec(profileID); err != nil {
+			return fmt.Errorf("failed to delete existing cons for %s: %w", profile.Name, err)
+		}
+
+		for _, pro := range profile.Pros {
+			if _, err := prosInsertStmt.Exec(profileID, pro); err != nil {
+				return fmt.Errorf("failed to insert pro for %s: %w", profile.Name, err)
+			}
+		}
+		for _, con := range profile.Cons {
+			if _, err := consInsertStmt.Exec(profileID, con); err != nil {
+				return fmt.Errorf("failed to insert con for %s: %w", profile.Name, err)
+			}
+		}
+
+		if onProgress != nil {
+			onProgress(i+1, len(profiles))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetProfile retrieves a profile by name
+func (d *SQLiteStore) GetProfile(name string) (*models.Profile, error) {
+	profile := &models.Profile{}
+
+	// Get profile data
+	row := d.db.QueryRow(`
+		SELECT name, url, verdict, description, score, category, image_url, created_at, updated_at 
+		FROM profiles 
+		WHERE name = ?
+	`, name)
+
+	err := row.Scan(
+		&profile.Name,
+		&profile.URL,
+		&profile.Verdict,
+		&profile.Description,
+		&profile.Score,
+		&profile.Category,
+		&profile.ImageURL,
+		&profile.CreatedAt,
+		&profile.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errNotFound(name)
+		}
+		return nil, fmt.Errorf("failed to scan profile: %w", err)
+	}
+
+	// Get profile ID
+	var profileID int
+	err = d.db.QueryRow("SELECT id FROM profiles WHERE name = ?", name).Scan(&profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile ID: %w", err)
+	}
+
+	// Get pros
+	prosRows, err := d.db.Query("SELECT text FROM pros WHERE profile_id = ?", profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pros: %w", err)
+	}
+	defer prosRows.Close()
+
+	for prosRows.Next() {
+		var pro string
+		if err := prosRows.Scan(&pro); err != nil {
+			return nil, fmt.Errorf("failed to scan pro: %w", err)
+		}
+		profile.Pros = append(profile.Pros, pro)
+	}
+
+	// Get cons
+	consRows, err := d.db.Query("SELECT text FROM cons WHERE profile_id = ?", profileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cons: %w", err)
+	}
+	defer consRows.Close()
+
+	for consRows.Next() {
+		var con string
+		if err := consRows.Scan(&con); err != nil {
+			return nil, fmt.Errorf("failed to scan con: %w", err)
+		}
+		profile.Cons = append(profile.Cons, con)
+	}
+
+	return profile, nil
+}
+
+// DeleteProfile removes profile by name. Its pros and cons rows are
+// removed by the schema's ON DELETE CASCADE foreign keys.
+func (d *SQLiteStore) DeleteProfile(name string) error {
+	res, err := d.db.Exec("DELETE FROM profiles WHERE name = ?", name)
+	if err != nil {
+		return fmt.Errorf("failed to delete profile: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check delete result: %w", err)
+	}
+	if affected == 0 {
+		return errNotFound(name)
+	}
+	return nil
+}
+
+// ListProfiles returns all profiles
+func (d *SQLiteStore) ListProfiles() ([]*models.Profile, error) {
+	return d.queryProfiles(`
+		SELECT id, name, url, verdict, description, score, category, image_url, created_at, updated_at
+		FROM profiles
+	`)
+}
+
+// SearchProfiles searches profiles by name, verdict, or description
+func (d *SQLiteStore) SearchProfiles(query string) ([]*models.Profile, error) {
+	pattern := "%" + query + "%"
+	return d.queryProfiles(`
+		SELECT id, name, url, verdict, description, score, category, image_url, created_at, updated_at
+		FROM profiles
+		WHERE name LIKE ? OR verdict LIKE ? OR description LIKE ?
+	`, pattern, pattern, pattern)
+}
+
+// SearchProfilesFTS searches profiles using the fts_profiles FTS5 virtual
+// table kept in sync by the profiles_a*/pros_a*/cons_a* triggers. query is
+// passed through verbatim as an FTS5 MATCH expression, so callers can use
+// quoted phrases ("albert einstein"), boolean OR, prefix matching (einst*),
+// and column filters (verdict:jew). Results are ordered by bm25 relevance
+// (SQLite's bm25() is more negative for better matches, hence ascending
+// order) and paginated with limit/offset. Profile columns are fetched by
+// joining profiles directly rather than a separate per-row lookup.
+func (d *SQLiteStore) SearchProfilesFTS(query string, limit, offset int) ([]*FTSSearchResult, error) {
+	rows, err := d.db.Query(`
+		SELECT p.id, p.name, p.url, p.verdict, p.description, p.score, p.category, p.image_url, p.created_at, p.updated_at,
+			bm25(fts_profiles, 3.0, 2.0, 1.0, 1.0, 1.0) AS rank,
+			snippet(fts_profiles, -1, '<mark>', '</mark>', '...', 10)
+		FROM fts_profiles
+		JOIN profiles p ON p.id = fts_profiles.profile_id
+		WHERE fts_profiles MATCH ?
+		ORDER BY rank
+		LIMIT ? OFFSET ?
+	`, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run fts query: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*FTSSearchResult
+	ids := make([]int64, 0)
+	byID := make(map[int64]*FTSSearchResult)
+	for rows.Next() {
+		var profileID int64
+		var rank float64
+		var snippet string
+		profile := &models.Profile{}
+		if err := rows.Scan(
+			&profileID,
+			&profile.Name,
+			&profile.URL,
+			&profile.Verdict,
+			&profile.Description,
+			&profile.Score,
+			&profile.Category,
+			&profile.ImageURL,
+			&profile.CreatedAt,
+			&profile.UpdatedAt,
+			&rank,
+			&snippet,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan fts result: %w", err)
+		}
+
+		result := &FTSSearchResult{Profile: profile, Rank: rank, Snippet: snippet}
+		results = append(results, result)
+		ids = append(ids, profileID)
+		byID[profileID] = result
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	pros, cons, err := d.getProsConsBatch(ids)
+	if err != nil {
+		return nil, err
+	}
+	for id, result := range byID {
+		result.Profile.Pros = pros[id]
+		result.Profile.Cons = cons[id]
+	}
+
+	return results, nil
+}
+
+// QueryProfilesFiltered returns profiles matching filter, built as a
+// single parameterized query rather than ListProfiles/SearchProfiles's
+// load-everything-then-filter-in-memory approach.
+func (d *SQLiteStore) QueryProfilesFiltered(filter ProfileFilter) ([]*models.Profile, error) {
+	var conditions []string
+	var args []interface{}
+	if filter.Category != "" {
+		conditions = append(conditions, "category = ?")
+		args = append(args, filter.Category)
+	}
+	if filter.Verdict != "" {
+		conditions = append(conditions, "verdict = ?")
+		args = append(args, filter.Verdict)
+	}
+	if filter.ScoreGte != nil {
+		conditions = append(conditions, "score >= ?")
+		args = append(args, *filter.ScoreGte)
+	}
+	if filter.NameContains != "" {
+		conditions = append(conditions, "name LIKE ?")
+		args = append(args, "%"+filter.NameContains+"%")
+	}
+
+	query := `
+		SELECT id, name, url, verdict, description, score, category, image_url, created_at, updated_at
+		FROM profiles
+	`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY " + sortColumn(filter.SortBy)
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+		if filter.Offset > 0 {
+			query += " OFFSET ?"
+			args = append(args, filter.Offset)
+		}
+	}
+
+	return d.queryProfiles(query, args...)
+}
+
+// queryProfiles runs query (expected to SELECT id, name, url, verdict,
+// description, score, category, image_url, created_at, updated_at in that
+// order) and batch-loads pros/cons for every returned row in two more
+// queries total, rather than one pair of queries per row - see
+// getProsConsBatch.
+func (d *SQLiteStore) queryProfiles(query string, args ...interface{}) ([]*models.Profile, error) {
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query profiles: %w", err)
+	}
+	defer rows.Close()
+
+	profiles := []*models.Profile{}
+	profileIDs := map[int64]*models.Profile{}
+	for rows.Next() {
+		profile := &models.Profile{}
+		var id int64
+		if err := rows.Scan(
+			&id,
+			&profile.Name,
+			&profile.URL,
+			&profile.Verdict,
+			&profile.Description,
+			&profile.Score,
+			&profile.Category,
+			&profile.ImageURL,
+			&profile.CreatedAt,
+			&profile.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan profile: %w", err)
+		}
+		profiles = append(profiles, profile)
+		profileIDs[id] = profile
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(profileIDs))
+	for id := range profileIDs {
+		ids = append(ids, id)
+	}
+	pros, cons, err := d.getProsConsBatch(ids)
+	if err != nil {
+		return nil, err
+	}
+	for id, profile := range profileIDs {
+		profile.Pros = pros[id]
+		profile.Cons = cons[id]
+	}
+	return profiles, nil
+}
+
+// getProsConsBatch loads the pros and cons for every id in profileIDs with
+// one query each (an IN (?, ?, ...) list built to profileIDs's length,
+// since the sqlite3 driver has no array-binding equivalent to Postgres's
+// = ANY($1)), so queryProfiles and SearchProfilesFTS issue two pros/cons
+// queries in total rather than two per returned profile.
+func (d *SQLiteStore) getProsConsBatch(profileIDs []int64) (pros, cons map[int64][]string, err error) {
+	pros = make(map[int64][]string, len(profileIDs))
+	cons = make(map[int64][]string, len(profileIDs))
+	if len(profileIDs) == 0 {
+		return pros, cons, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(profileIDs)), ",")
+	args := make([]interface{}, len(profileIDs))
+	for i, id := range profileIDs {
+		args[i] = id
+	}
+
+	prosRows, err := d.db.Query("SELECT profile_id, text FROM pros WHERE profile_id IN ("+placeholders+")", args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query pros: %w", err)
+	}
+	for prosRows.Next() {
+		var id int64
+		var text string
+		if err := prosRows.Scan(&id, &text); err != nil {
+			prosRows.Close()
+			return nil, nil, fmt.Errorf("failed to scan pro: %w", err)
+		}
+		pros[id] = append(pros[id], text)
+	}
+	prosRows.Close()
+
+	consRows, err := d.db.Query("SELECT profile_id, text FROM cons WHERE profile_id IN ("+placeholders+")", args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query cons: %w", err)
+	}
+	for consRows.Next() {
+		var id int64
+		var text string
+		if err := consRows.Scan(&id, &text); err != nil {
+			consRows.Close()
+			return nil, nil, fmt.Errorf("failed to scan con: %w", err)
+		}
+		cons[id] = append(cons[id], text)
+	}
+	consRows.Close()
+
+	return pros, cons, nil
+}
+
+// InsertWebmention records a webmention for the profile named
+// profileName, upserting on (profile_id, source_url).
+func (d *SQLiteStore) InsertWebmention(profileName string, wm *models.Webmention) error {
+	var profileID int64
+	if err := d.db.QueryRow("SELECT id FROM profiles WHERE name = ?", profileName).Scan(&profileID); err != nil {
+		if err == sql.ErrNoRows {
+			return errNotFound(profileName)
+		}
+		return fmt.Errorf("failed to look up profile: %w", err)
+	}
+
+	_, err := d.db.Exec(`
+		INSERT INTO webmentions (profile_id, source_url, type, author_name, author_photo, content, published_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (profile_id, source_url) DO UPDATE SET
+			type = excluded.type,
+			author_name = excluded.author_name,
+			author_photo = excluded.author_photo,
+			content = excluded.content,
+			published_at = excluded.published_at
+	`, profileID, wm.SourceURL, wm.Type, wm.AuthorName, wm.AuthorPhoto, wm.Content, wm.PublishedAt, wm.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert webmention: %w", err)
+	}
+	return nil
+}
+
+// ListWebmentions returns every webmention recorded for the profile
+// named profileName, oldest first.
+func (d *SQLiteStore) ListWebmentions(profileName string) ([]*models.Webmention, error) {
+	rows, err := d.db.Query(`
+		SELECT w.source_url, w.type, w.author_name, w.author_photo, w.content, w.published_at, w.created_at
+		FROM webmentions w
+		JOIN profiles p ON p.id = w.profile_id
+		WHERE p.name = ?
+		ORDER BY w.created_at ASC
+	`, profileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webmentions: %w", err)
+	}
+	defer rows.Close()
+
+	var mentions []*models.Webmention
+	for rows.Next() {
+		wm := &models.Webmention{}
+		if err := rows.Scan(&wm.SourceURL, &wm.Type, &wm.AuthorName, &wm.AuthorPhoto, &wm.Content, &wm.PublishedAt, &wm.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webmention: %w", err)
+		}
+		mentions = append(mentions, wm)
+	}
+	return mentions, nil
+}
+
+// Close closes the database connection
+func (d *SQLiteStore) Close() error {
+	return d.db.Close()
+}
\ No newline at end of file
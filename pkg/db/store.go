@@ -0,0 +1,145 @@
+// Package db persists scraped profiles behind a pluggable Store so
+// operators can run the API server against Postgres in production while
+// the scraper and CLI keep using a local SQLite file. Both backends bring
+// their schema up to date via the embedded, versioned migrations in
+// migrations/sqlite and migrations/postgres (see migrate.go and cmd/migrate).
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// ProgressFunc optionally reports bulk-import progress from InsertProfiles
+// as ProgressFunc(done, total). Implementations call it synchronously
+// after each row's pros/cons are written, inside the one transaction that
+// commits after the last row, so it's safe to drive a progress bar from it. A nil ProgressFunc means "don't report."
+type ProgressFunc func(done, total int)
+
+// Store is the persistence interface implemented by SQLiteStore and
+// PostgresStore. It mirrors the original *DB method set so cmd/api,
+// cmd/cli, and pkg/api can depend on the interface instead of a concrete
+// database.
+type Store interface {
+	// InitSchema brings the database up to date, applying any migrations
+	// that haven't run yet. New calls this automatically.
+	InitSchema() error
+
+	// InsertProfile inserts or updates profile, keeping pros/cons and the
+	// full-text index in sync. Equivalent to InsertProfiles with a single
+	// profile and no progress callback.
+	InsertProfile(profile *models.Profile) error
+
+	// InsertProfiles bulk-inserts or updates profiles in a single
+	// transaction, reusing prepared statements across every row instead of
+	// the one-transaction-per-row pattern InsertProfile uses for a lone
+	// profile. onProgress, if non-nil, is called after each row.
+	InsertProfiles(profiles []*models.Profile, onProgress ProgressFunc) error
+
+	// GetProfile retrieves a profile by name.
+	GetProfile(name string) (*models.Profile, error)
+
+	// DeleteProfile removes profile by name, along with its pros and cons
+	// (cascaded by the schema's foreign keys). It returns errNotFound if no
+	// profile with that name exists.
+	DeleteProfile(name string) error
+
+	// ListProfiles returns all profiles.
+	ListProfiles() ([]*models.Profile, error)
+
+	// SearchProfiles does a substring search over name/verdict/description.
+	SearchProfiles(query string) ([]*models.Profile, error)
+
+	// SearchProfilesFTS ranks profiles against a full-text query, with
+	// pagination and a highlighted snippet per result.
+	SearchProfilesFTS(query string, limit, offset int) ([]*FTSSearchResult, error)
+
+	// QueryProfilesFiltered returns profiles matching filter. Unlike
+	// ListProfiles/SearchProfiles, whose callers filter and paginate the
+	// returned slice themselves, filter's fields are translated directly
+	// into SQL WHERE/ORDER BY/LIMIT/OFFSET clauses, so the database does
+	// the work for result sets too large to comfortably hold in memory.
+	QueryProfilesFiltered(filter ProfileFilter) ([]*models.Profile, error)
+
+	// InsertWebmention records a webmention discovered for the profile
+	// named profileName. It upserts on (profile_id, source_url), so a site
+	// re-sending the same webmention (e.g. after editing its post) updates
+	// the stored mention rather than duplicating it.
+	InsertWebmention(profileName string, wm *models.Webmention) error
+
+	// ListWebmentions returns every webmention recorded for the profile
+	// named profileName, oldest first.
+	ListWebmentions(profileName string) ([]*models.Webmention, error)
+
+	// Close releases any resources held by the store.
+	Close() error
+}
+
+// FTSSearchResult pairs a profile with its full-text relevance rank and a
+// highlighted snippet from whichever indexed field matched the query. The
+// rank scale differs by backend (SQLite's bm25() is negative, Postgres's
+// ts_rank is positive) so callers should treat it as ordering-only, not a
+// cross-backend-comparable score.
+type FTSSearchResult struct {
+	Profile *models.Profile `json:"profile"`
+	Rank    float64         `json:"rank"`
+	Snippet string          `json:"snippet"`
+}
+
+// ProfileFilter narrows and orders a QueryProfilesFiltered call. A zero
+// value (every field empty) matches every profile, ordered by name.
+type ProfileFilter struct {
+	// Category and Verdict, when non-empty, require an exact match.
+	Category string
+	Verdict  string
+
+	// ScoreGte, when non-nil, requires score >= *ScoreGte.
+	ScoreGte *float64
+
+	// NameContains, when non-empty, requires a case-insensitive substring
+	// match against name.
+	NameContains string
+
+	// SortBy is one of "name", "score", or "updatedAt"; anything else
+	// (including empty) falls back to "name".
+	SortBy string
+
+	// Limit caps the number of rows returned; 0 means unbounded. Offset
+	// only applies when Limit is set, matching SQL's LIMIT/OFFSET pairing.
+	Limit  int
+	Offset int
+}
+
+// sortColumn maps a ProfileFilter.SortBy value to the ORDER BY clause it
+// selects, defaulting to name for an empty or unrecognized value. Both
+// backends use the same column names, so this is shared rather than
+// duplicated per-backend.
+func sortColumn(sortBy string) string {
+	switch sortBy {
+	case "score":
+		return "score DESC"
+	case "updatedAt":
+		return "updated_at DESC"
+	default:
+		return "name ASC"
+	}
+}
+
+// New opens a Store based on dsn: a "postgres://" or "postgresql://" DSN
+// selects PostgresStore, anything else is treated as a SQLite file path.
+// The schema is brought up to date via InitSchema before New returns.
+func New(dsn string) (Store, error) {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		return NewPostgresStore(dsn)
+	}
+	return NewSQLiteStore(dsn)
+}
+
+// errNotFound formats the "profile not found" error both backends return
+// from GetProfile, so callers can rely on the same substring (see
+// pkg/api/server.go's strings.Contains check) regardless of backend.
+func errNotFound(name string) error {
+	return fmt.Errorf("profile not found: %s", name)
+}
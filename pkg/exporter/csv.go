@@ -0,0 +1,49 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// csvListSep separates multiple pros/cons within a single CSV field, since
+// CSV has no native list type. Matches pkg/importer's CSVImporter.
+const csvListSep = ";"
+
+var csvHeader = []string{
+	"name", "url", "verdict", "description", "pros", "cons",
+	"score", "category", "image_url", "created_at", "updated_at",
+}
+
+func exportCSV(profiles []*models.Profile, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create csv file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	writer := csv.NewWriter(f)
+	if err := writer.Write(csvHeader); err != nil {
+		return fmt.Errorf("failed to write csv header to %s: %w", path, err)
+	}
+
+	for _, p := range profiles {
+		row := []string{
+			p.Name, p.URL, p.Verdict, p.Description,
+			strings.Join(p.Pros, csvListSep),
+			strings.Join(p.Cons, csvListSep),
+			strconv.FormatFloat(p.Score, 'f', -1, 64),
+			p.Category, p.ImageURL, p.CreatedAt, p.UpdatedAt,
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write csv row for %s: %w", p.Name, err)
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,23 @@
+// Package exporter writes a corpus of profiles out to formats other tools
+// can consume, the mirror image of pkg/importer.
+package exporter
+
+import (
+	"fmt"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// Export writes profiles to path in format ("csv", "ndjson", or "json").
+func Export(profiles []*models.Profile, format, path string) error {
+	switch format {
+	case "csv":
+		return exportCSV(profiles, path)
+	case "ndjson":
+		return exportNDJSON(profiles, path)
+	case "json":
+		return exportJSON(profiles, path)
+	default:
+		return fmt.Errorf("unknown export format: %s (want csv, ndjson, or json)", format)
+	}
+}
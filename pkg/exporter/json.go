@@ -0,0 +1,21 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// exportJSON writes all profiles as a single merged JSON array.
+func exportJSON(profiles []*models.Profile, path string) error {
+	data, err := json.MarshalIndent(profiles, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal profiles to JSON: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write json file %s: %w", path, err)
+	}
+	return nil
+}
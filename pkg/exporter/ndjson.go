@@ -0,0 +1,28 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// exportNDJSON writes one JSON-encoded profile per line, for tools that
+// stream records rather than load a whole array at once.
+func exportNDJSON(profiles []*models.Profile, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create ndjson file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	encoder := json.NewEncoder(f)
+	for _, p := range profiles {
+		if err := encoder.Encode(p); err != nil {
+			return fmt.Errorf("failed to encode profile %s: %w", p.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package extractors
+
+import "github.com/marcusziade/jewornotjew/pkg/adapter"
+
+// DefaultChain is the built-in backend order: the declarative selector rules
+// in backend (see pkg/adapter) first, then OpenGraph tags, then JSON-LD,
+// each only filling in fields the previous backend left unset. Callers that
+// want to add or reorder backends should pass their own Chain via
+// client.WithExtractors instead of calling this directly.
+func DefaultChain(backend adapter.SiteAdapter) Chain {
+	return Chain{
+		NewHeuristicExtractor(backend),
+		NewOpenGraphExtractor(),
+		NewJSONLDExtractor(),
+	}
+}
@@ -0,0 +1,80 @@
+// Package extractors decouples profile field extraction from any single
+// parsing strategy. pkg/adapter already lets a whole site's scrape rules be
+// swapped out; ProfileExtractor goes one level further and lets individual
+// fields fall back across parsing strategies (declarative selectors,
+// OpenGraph tags, JSON-LD) for one site, so a change in how jewornotjew.com
+// renders a profile doesn't need to break every field at once.
+package extractors
+
+import (
+	"github.com/PuerkitoBio/goquery"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// ProfileExtractor pulls individual Profile fields out of a parsed document.
+// Each method reports ok=false when it has no opinion about the field (not
+// found, or the strategy doesn't apply to that field at all), so Chain can
+// move on to the next backend without mistaking "didn't look" for "found
+// empty".
+type ProfileExtractor interface {
+	// Name identifies the backend for logging/debugging.
+	Name() string
+
+	ExtractName(doc *goquery.Document) (string, bool)
+	ExtractVerdict(doc *goquery.Document) (string, bool)
+	ExtractDescription(doc *goquery.Document) (string, bool)
+	ExtractCategory(doc *goquery.Document) (string, bool)
+	// ExtractImage resolves a possibly site-relative image URL against baseURL.
+	ExtractImage(doc *goquery.Document, baseURL string) (string, bool)
+	ExtractPros(doc *goquery.Document) ([]string, bool)
+	ExtractCons(doc *goquery.Document) ([]string, bool)
+}
+
+// Chain is an ordered list of ProfileExtractor backends. Apply tries each
+// backend in turn for every field still unset on profile, so the first
+// backend to produce a value for a field wins and later backends only fill
+// in gaps.
+type Chain []ProfileExtractor
+
+// Apply fills in profile by trying each backend in chain, in order, for
+// every field it hasn't already set.
+func (chain Chain) Apply(doc *goquery.Document, baseURL string, profile *models.Profile) *models.Profile {
+	for _, ex := range chain {
+		if profile.Name == "" {
+			if v, ok := ex.ExtractName(doc); ok && v != "" {
+				profile.Name = v
+			}
+		}
+		if profile.Verdict == "" {
+			if v, ok := ex.ExtractVerdict(doc); ok && v != "" {
+				profile.Verdict = v
+			}
+		}
+		if profile.Description == "" {
+			if v, ok := ex.ExtractDescription(doc); ok && v != "" {
+				profile.Description = v
+			}
+		}
+		if profile.Category == "" {
+			if v, ok := ex.ExtractCategory(doc); ok && v != "" {
+				profile.Category = v
+			}
+		}
+		if profile.ImageURL == "" {
+			if v, ok := ex.ExtractImage(doc, baseURL); ok && v != "" {
+				profile.ImageURL = v
+			}
+		}
+		if len(profile.Pros) == 0 {
+			if v, ok := ex.ExtractPros(doc); ok && len(v) > 0 {
+				profile.Pros = v
+			}
+		}
+		if len(profile.Cons) == 0 {
+			if v, ok := ex.ExtractCons(doc); ok && len(v) > 0 {
+				profile.Cons = v
+			}
+		}
+	}
+	return profile
+}
@@ -0,0 +1,157 @@
+package extractors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// fakeExtractor lets tests control exactly which fields a backend claims an
+// opinion on, without needing a real parsing strategy.
+type fakeExtractor struct {
+	name                 string
+	nameVal, verdictVal  string
+	nameOK, verdictOK    bool
+	descVal, categoryVal string
+	descOK, categoryOK   bool
+	imageVal             string
+	imageOK              bool
+	prosVal, consVal     []string
+	prosOK, consOK       bool
+}
+
+func (f *fakeExtractor) Name() string { return f.name }
+func (f *fakeExtractor) ExtractName(doc *goquery.Document) (string, bool) {
+	return f.nameVal, f.nameOK
+}
+func (f *fakeExtractor) ExtractVerdict(doc *goquery.Document) (string, bool) {
+	return f.verdictVal, f.verdictOK
+}
+func (f *fakeExtractor) ExtractDescription(doc *goquery.Document) (string, bool) {
+	return f.descVal, f.descOK
+}
+func (f *fakeExtractor) ExtractCategory(doc *goquery.Document) (string, bool) {
+	return f.categoryVal, f.categoryOK
+}
+func (f *fakeExtractor) ExtractImage(doc *goquery.Document, baseURL string) (string, bool) {
+	return f.imageVal, f.imageOK
+}
+func (f *fakeExtractor) ExtractPros(doc *goquery.Document) ([]string, bool) {
+	return f.prosVal, f.prosOK
+}
+func (f *fakeExtractor) ExtractCons(doc *goquery.Document) ([]string, bool) {
+	return f.consVal, f.consOK
+}
+
+func emptyDoc(t *testing.T) *goquery.Document {
+	t.Helper()
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html></html>"))
+	if err != nil {
+		t.Fatalf("failed to parse empty doc: %v", err)
+	}
+	return doc
+}
+
+func TestChainApplyFirstBackendWins(t *testing.T) {
+	first := &fakeExtractor{name: "first", nameVal: "Albert Einstein", nameOK: true}
+	second := &fakeExtractor{name: "second", nameVal: "Someone Else", nameOK: true}
+	chain := Chain{first, second}
+
+	profile := chain.Apply(emptyDoc(t), "http://example.com", &models.Profile{})
+
+	if profile.Name != "Albert Einstein" {
+		t.Errorf("Name = %q, want the first backend's value", profile.Name)
+	}
+}
+
+func TestChainApplyLaterBackendFillsGap(t *testing.T) {
+	first := &fakeExtractor{name: "first", nameVal: "Albert Einstein", nameOK: true}
+	second := &fakeExtractor{name: "second", verdictVal: "Jew", verdictOK: true}
+	chain := Chain{first, second}
+
+	profile := chain.Apply(emptyDoc(t), "http://example.com", &models.Profile{})
+
+	if profile.Name != "Albert Einstein" {
+		t.Errorf("Name = %q, want Albert Einstein", profile.Name)
+	}
+	if profile.Verdict != "Jew" {
+		t.Errorf("Verdict = %q, want Jew", profile.Verdict)
+	}
+}
+
+func TestChainApplyIgnoresOKFalseAndEmptyValues(t *testing.T) {
+	notOK := &fakeExtractor{name: "not-ok", nameVal: "ignored", nameOK: false}
+	okButEmpty := &fakeExtractor{name: "empty", descVal: "", descOK: true}
+	real := &fakeExtractor{name: "real", nameVal: "Madonna", nameOK: true, descVal: "Queen of Pop", descOK: true}
+	chain := Chain{notOK, okButEmpty, real}
+
+	profile := chain.Apply(emptyDoc(t), "http://example.com", &models.Profile{})
+
+	if profile.Name != "Madonna" {
+		t.Errorf("Name = %q, want Madonna", profile.Name)
+	}
+	if profile.Description != "Queen of Pop" {
+		t.Errorf("Description = %q, want Queen of Pop", profile.Description)
+	}
+}
+
+func TestOpenGraphExtractor(t *testing.T) {
+	html := `<html><head>
+		<meta property="og:title" content="Albert Einstein">
+		<meta property="og:description" content="Theoretical physicist">
+		<meta property="article:section" content="Science">
+		<meta property="og:image" content="/images/einstein.jpg">
+	</head></html>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("failed to parse doc: %v", err)
+	}
+
+	og := NewOpenGraphExtractor()
+
+	if name, ok := og.ExtractName(doc); !ok || name != "Albert Einstein" {
+		t.Errorf("ExtractName() = (%q, %v), want (Albert Einstein, true)", name, ok)
+	}
+	if desc, ok := og.ExtractDescription(doc); !ok || desc != "Theoretical physicist" {
+		t.Errorf("ExtractDescription() = (%q, %v), want (Theoretical physicist, true)", desc, ok)
+	}
+	if cat, ok := og.ExtractCategory(doc); !ok || cat != "Science" {
+		t.Errorf("ExtractCategory() = (%q, %v), want (Science, true)", cat, ok)
+	}
+	if img, ok := og.ExtractImage(doc, "http://jewornotjew.com"); !ok || img != "http://jewornotjew.com/images/einstein.jpg" {
+		t.Errorf("ExtractImage() = (%q, %v), want an absolute URL joined to baseURL", img, ok)
+	}
+	if verdict, ok := og.ExtractVerdict(doc); ok || verdict != "" {
+		t.Errorf("ExtractVerdict() = (%q, %v), want (\"\", false): OpenGraph has no opinion on verdict", verdict, ok)
+	}
+	if pros, ok := og.ExtractPros(doc); ok || pros != nil {
+		t.Errorf("ExtractPros() = (%v, %v), want (nil, false)", pros, ok)
+	}
+}
+
+func TestOpenGraphExtractorAbsoluteImageURLPassedThrough(t *testing.T) {
+	html := `<html><head><meta property="og:image" content="http://cdn.example.com/e.jpg"></head></html>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("failed to parse doc: %v", err)
+	}
+
+	og := NewOpenGraphExtractor()
+	if img, ok := og.ExtractImage(doc, "http://jewornotjew.com"); !ok || img != "http://cdn.example.com/e.jpg" {
+		t.Errorf("ExtractImage() = (%q, %v), want the absolute URL unchanged", img, ok)
+	}
+}
+
+func TestOpenGraphExtractorMissingTagsReportNotOK(t *testing.T) {
+	doc := emptyDoc(t)
+	og := NewOpenGraphExtractor()
+
+	if _, ok := og.ExtractName(doc); ok {
+		t.Error("ExtractName() on a doc with no og:title should report ok=false")
+	}
+	if _, ok := og.ExtractImage(doc, "http://jewornotjew.com"); ok {
+		t.Error("ExtractImage() on a doc with no og:image should report ok=false")
+	}
+}
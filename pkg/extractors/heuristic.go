@@ -0,0 +1,70 @@
+package extractors
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/marcusziade/jewornotjew/pkg/adapter"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// HeuristicExtractor adapts a pkg/adapter.SiteAdapter (the declarative,
+// fallback-chained selector rules, or a custom SiteAdapter passed via
+// client.WithAdapter) into a ProfileExtractor, so the existing heuristic
+// parser is just the first backend in a Chain rather than the only one.
+type HeuristicExtractor struct {
+	adapter adapter.SiteAdapter
+}
+
+// NewHeuristicExtractor wraps a as a ProfileExtractor.
+func NewHeuristicExtractor(a adapter.SiteAdapter) *HeuristicExtractor {
+	return &HeuristicExtractor{adapter: a}
+}
+
+func (h *HeuristicExtractor) Name() string {
+	return "heuristic"
+}
+
+// parse runs the wrapped adapter's ParseProfile once against a scratch
+// profile so each Extract* method can report what it found.
+func (h *HeuristicExtractor) parse(doc *goquery.Document) *models.Profile {
+	return h.adapter.ParseProfile(doc, &models.Profile{})
+}
+
+func (h *HeuristicExtractor) ExtractName(doc *goquery.Document) (string, bool) {
+	p := h.parse(doc)
+	return p.Name, p.Name != ""
+}
+
+func (h *HeuristicExtractor) ExtractVerdict(doc *goquery.Document) (string, bool) {
+	p := h.parse(doc)
+	return p.Verdict, p.Verdict != ""
+}
+
+func (h *HeuristicExtractor) ExtractDescription(doc *goquery.Document) (string, bool) {
+	p := h.parse(doc)
+	return p.Description, p.Description != ""
+}
+
+func (h *HeuristicExtractor) ExtractCategory(doc *goquery.Document) (string, bool) {
+	p := h.parse(doc)
+	return p.Category, p.Category != ""
+}
+
+func (h *HeuristicExtractor) ExtractImage(doc *goquery.Document, baseURL string) (string, bool) {
+	p := h.parse(doc)
+	if p.ImageURL == "" || strings.HasPrefix(p.ImageURL, "http") {
+		return p.ImageURL, p.ImageURL != ""
+	}
+	return strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(p.ImageURL, "/"), true
+}
+
+func (h *HeuristicExtractor) ExtractPros(doc *goquery.Document) ([]string, bool) {
+	p := h.parse(doc)
+	return p.Pros, len(p.Pros) > 0
+}
+
+func (h *HeuristicExtractor) ExtractCons(doc *goquery.Document) ([]string, bool) {
+	p := h.parse(doc)
+	return p.Cons, len(p.Cons) > 0
+}
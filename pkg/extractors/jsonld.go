@@ -0,0 +1,122 @@
+package extractors
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// jsonLDPerson is the subset of schema.org/Person fields this backend reads
+// out of a <script type="application/ld+json"> block. Image is typed as
+// json.RawMessage because schema.org allows it to be either a bare URL
+// string or an ImageObject with its own "url" field.
+type jsonLDPerson struct {
+	Type        string          `json:"@type"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	JobTitle    string          `json:"jobTitle"`
+	Image       json.RawMessage `json:"image"`
+}
+
+// JSONLDExtractor reads a schema.org Person record out of the document's
+// ld+json script tags. Like OpenGraphExtractor, it has no concept of a
+// verdict or of pros/cons.
+type JSONLDExtractor struct{}
+
+// NewJSONLDExtractor returns a JSONLDExtractor.
+func NewJSONLDExtractor() *JSONLDExtractor {
+	return &JSONLDExtractor{}
+}
+
+func (j *JSONLDExtractor) Name() string {
+	return "jsonld"
+}
+
+// person scans the document's ld+json script tags for the first one
+// describing a schema.org Person, or nil if there is none.
+func (j *JSONLDExtractor) person(doc *goquery.Document) *jsonLDPerson {
+	var found *jsonLDPerson
+	doc.Find(`script[type="application/ld+json"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		var p jsonLDPerson
+		if err := json.Unmarshal([]byte(s.Text()), &p); err != nil {
+			return true
+		}
+		if strings.Contains(p.Type, "Person") {
+			found = &p
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// imageURL extracts a bare URL string from a schema.org image value, which
+// may be either the URL itself or an ImageObject with a "url" field.
+func imageURL(raw json.RawMessage) (string, bool) {
+	if len(raw) == 0 {
+		return "", false
+	}
+	var asString string
+	if err := json.Unmarshal(raw, &asString); err == nil {
+		return asString, asString != ""
+	}
+	var asObject struct {
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal(raw, &asObject); err == nil {
+		return asObject.URL, asObject.URL != ""
+	}
+	return "", false
+}
+
+func (j *JSONLDExtractor) ExtractName(doc *goquery.Document) (string, bool) {
+	p := j.person(doc)
+	if p == nil {
+		return "", false
+	}
+	return p.Name, p.Name != ""
+}
+
+func (j *JSONLDExtractor) ExtractVerdict(doc *goquery.Document) (string, bool) {
+	return "", false
+}
+
+func (j *JSONLDExtractor) ExtractDescription(doc *goquery.Document) (string, bool) {
+	p := j.person(doc)
+	if p == nil {
+		return "", false
+	}
+	return p.Description, p.Description != ""
+}
+
+func (j *JSONLDExtractor) ExtractCategory(doc *goquery.Document) (string, bool) {
+	p := j.person(doc)
+	if p == nil {
+		return "", false
+	}
+	return p.JobTitle, p.JobTitle != ""
+}
+
+func (j *JSONLDExtractor) ExtractImage(doc *goquery.Document, baseURL string) (string, bool) {
+	p := j.person(doc)
+	if p == nil {
+		return "", false
+	}
+	url, ok := imageURL(p.Image)
+	if !ok {
+		return "", false
+	}
+	if strings.HasPrefix(url, "http") {
+		return url, true
+	}
+	return strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(url, "/"), true
+}
+
+func (j *JSONLDExtractor) ExtractPros(doc *goquery.Document) ([]string, bool) {
+	return nil, false
+}
+
+func (j *JSONLDExtractor) ExtractCons(doc *goquery.Document) ([]string, bool) {
+	return nil, false
+}
@@ -0,0 +1,67 @@
+package extractors
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// OpenGraphExtractor reads og:* meta tags (and the article:section tag some
+// CMSes emit for a category-like grouping). It has no concept of a
+// Jew/Not-a-Jew verdict or of pros/cons, so those Extract methods always
+// report ok=false and let an earlier or later backend in the Chain handle them.
+type OpenGraphExtractor struct{}
+
+// NewOpenGraphExtractor returns an OpenGraphExtractor.
+func NewOpenGraphExtractor() *OpenGraphExtractor {
+	return &OpenGraphExtractor{}
+}
+
+func (o *OpenGraphExtractor) Name() string {
+	return "opengraph"
+}
+
+func (o *OpenGraphExtractor) metaContent(doc *goquery.Document, property string) (string, bool) {
+	sel := doc.Find(`meta[property="` + property + `"]`).First()
+	if sel.Length() == 0 {
+		return "", false
+	}
+	content, exists := sel.Attr("content")
+	content = strings.TrimSpace(content)
+	return content, exists && content != ""
+}
+
+func (o *OpenGraphExtractor) ExtractName(doc *goquery.Document) (string, bool) {
+	return o.metaContent(doc, "og:title")
+}
+
+func (o *OpenGraphExtractor) ExtractVerdict(doc *goquery.Document) (string, bool) {
+	return "", false
+}
+
+func (o *OpenGraphExtractor) ExtractDescription(doc *goquery.Document) (string, bool) {
+	return o.metaContent(doc, "og:description")
+}
+
+func (o *OpenGraphExtractor) ExtractCategory(doc *goquery.Document) (string, bool) {
+	return o.metaContent(doc, "article:section")
+}
+
+func (o *OpenGraphExtractor) ExtractImage(doc *goquery.Document, baseURL string) (string, bool) {
+	image, ok := o.metaContent(doc, "og:image")
+	if !ok {
+		return "", false
+	}
+	if strings.HasPrefix(image, "http") {
+		return image, true
+	}
+	return strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(image, "/"), true
+}
+
+func (o *OpenGraphExtractor) ExtractPros(doc *goquery.Document) ([]string, bool) {
+	return nil, false
+}
+
+func (o *OpenGraphExtractor) ExtractCons(doc *goquery.Document) ([]string, bool) {
+	return nil, false
+}
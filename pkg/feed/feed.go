@@ -0,0 +1,147 @@
+// Package feed builds RSS 2.0 and Atom 1.0 documents from a small,
+// format-agnostic Feed/Item model - the same shape gorilla/feeds uses -
+// without taking on an XML library dependency for two encoding/xml struct
+// trees.
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// Item is one entry in a Feed.
+type Item struct {
+	Title       string
+	Link        string
+	Description string
+	// Id is the entry's stable identifier (an RSS guid / Atom id).
+	Id         string
+	Categories []string
+	Created    time.Time
+	Updated    time.Time
+}
+
+// Feed is a list of Items with the metadata both RSS and Atom need at the
+// top level.
+type Feed struct {
+	Title       string
+	Link        string
+	Description string
+	// Updated is the feed's own last-modified time, normally the newest
+	// Item.Updated in Items.
+	Updated time.Time
+	Items   []*Item
+}
+
+type rssFeed struct {
+	XMLName xml.Name  `xml:"rss"`
+	Version string    `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	LastBuildDate string  `xml:"lastBuildDate"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string      `xml:"title"`
+	Link        string      `xml:"link"`
+	Description string      `xml:"description"`
+	Categories  []string    `xml:"category"`
+	Guid        rssGUID     `xml:"guid"`
+	PubDate     string      `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// ToRSS renders f as an RSS 2.0 document.
+func (f *Feed) ToRSS() ([]byte, error) {
+	channel := rssChannel{
+		Title:         f.Title,
+		Link:          f.Link,
+		Description:   f.Description,
+		LastBuildDate: f.Updated.Format(time.RFC1123Z),
+	}
+	for _, item := range f.Items {
+		channel.Items = append(channel.Items, rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			Description: item.Description,
+			Categories:  item.Categories,
+			Guid:        rssGUID{IsPermaLink: "false", Value: item.Id},
+			PubDate:     item.Updated.Format(time.RFC1123Z),
+		})
+	}
+
+	out := rssFeed{Version: "2.0", Channel: channel}
+	body, err := xml.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	Link    atomLink    `xml:"link"`
+	Id      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Id         string         `xml:"id"`
+	Updated    string         `xml:"updated"`
+	Published  string         `xml:"published"`
+	Summary    string         `xml:"summary"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// ToAtom renders f as an Atom 1.0 document.
+func (f *Feed) ToAtom() ([]byte, error) {
+	out := atomFeed{
+		Title:   f.Title,
+		Link:    atomLink{Href: f.Link},
+		Id:      f.Link,
+		Updated: f.Updated.Format(time.RFC3339),
+	}
+	for _, item := range f.Items {
+		var categories []atomCategory
+		for _, c := range item.Categories {
+			categories = append(categories, atomCategory{Term: c})
+		}
+		out.Entries = append(out.Entries, atomEntry{
+			Title:      item.Title,
+			Link:       atomLink{Href: item.Link},
+			Id:         item.Id,
+			Updated:    item.Updated.Format(time.RFC3339),
+			Published:  item.Created.Format(time.RFC3339),
+			Summary:    item.Description,
+			Categories: categories,
+		})
+	}
+
+	body, err := xml.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
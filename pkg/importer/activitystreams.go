@@ -0,0 +1,66 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// asObject is the subset of an ActivityStreams object this importer reads.
+// Image is typed as a raw string here (rather than the full
+// Link-or-string-or-array shape the spec allows) since that's the common
+// case in practice; an image given in another shape fails to parse.
+type asObject struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Summary string `json:"summary"`
+	ID      string `json:"id"`
+	Image   string `json:"image"`
+}
+
+// asCollection is an ActivityStreams Collection of asObject items.
+type asCollection struct {
+	Type  string     `json:"type"`
+	Items []asObject `json:"items"`
+	// OrderedItems is accepted as an alias of Items, since
+	// OrderedCollection is the more common shape in practice.
+	OrderedItems []asObject `json:"orderedItems"`
+}
+
+// ActivityStreamsImporter reads a JSON-LD ActivityStreams (Ordered)Collection
+// of Person objects and converts each into a Profile.
+type ActivityStreamsImporter struct{}
+
+func (a *ActivityStreamsImporter) Import(path string) ([]*models.Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read activitystreams collection %s: %w", path, err)
+	}
+
+	var collection asCollection
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, fmt.Errorf("failed to parse activitystreams collection %s: %w", path, err)
+	}
+
+	items := collection.Items
+	if len(items) == 0 {
+		items = collection.OrderedItems
+	}
+
+	profiles := make([]*models.Profile, 0, len(items))
+	for _, item := range items {
+		if item.Name == "" {
+			continue
+		}
+		profiles = append(profiles, &models.Profile{
+			Name:        item.Name,
+			URL:         item.ID,
+			Description: item.Summary,
+			ImageURL:    item.Image,
+		})
+	}
+
+	return profiles, nil
+}
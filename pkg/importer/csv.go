@@ -0,0 +1,83 @@
+package importer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// csvListSep separates multiple pros/cons within a single CSV field, since
+// CSV has no native list type.
+const csvListSep = ";"
+
+// CSVImporter reads a CSV file whose header row names Profile fields
+// (name, url, verdict, description, pros, cons, score, category, image_url,
+// created_at, updated_at — matching the models.Profile json tags). Columns
+// may appear in any order, and not every column is required; pros/cons
+// cells hold csvListSep-separated items.
+type CSVImporter struct{}
+
+func (c *CSVImporter) Import(path string) ([]*models.Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open csv file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv file %s: %w", path, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int, len(rows[0]))
+	for i, name := range rows[0] {
+		columns[strings.TrimSpace(strings.ToLower(name))] = i
+	}
+
+	cell := func(row []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	profiles := make([]*models.Profile, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		profile := &models.Profile{
+			Name:        cell(row, "name"),
+			URL:         cell(row, "url"),
+			Verdict:     cell(row, "verdict"),
+			Description: cell(row, "description"),
+			Category:    cell(row, "category"),
+			ImageURL:    cell(row, "image_url"),
+			CreatedAt:   cell(row, "created_at"),
+			UpdatedAt:   cell(row, "updated_at"),
+		}
+		if profile.Name == "" {
+			continue
+		}
+		if pros := cell(row, "pros"); pros != "" {
+			profile.Pros = strings.Split(pros, csvListSep)
+		}
+		if cons := cell(row, "cons"); cons != "" {
+			profile.Cons = strings.Split(cons, csvListSep)
+		}
+		if score := cell(row, "score"); score != "" {
+			if v, err := strconv.ParseFloat(score, 64); err == nil {
+				profile.Score = v
+			}
+		}
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
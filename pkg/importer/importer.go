@@ -0,0 +1,32 @@
+// Package importer converts third-party profile datasets into
+// *models.Profile, so a corpus can be seeded or merged from outside sources
+// without a scrape. Each flavor implements Importer; New dispatches on the
+// flavor name.
+package importer
+
+import (
+	"fmt"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// Importer reads a third-party dataset at path and converts its records
+// into Profiles.
+type Importer interface {
+	Import(path string) ([]*models.Profile, error)
+}
+
+// New returns the Importer for flavor, or an error if flavor isn't one of
+// the built-in flavors ("wikidata", "csv", "activitystreams").
+func New(flavor string) (Importer, error) {
+	switch flavor {
+	case "wikidata":
+		return &WikidataImporter{}, nil
+	case "csv":
+		return &CSVImporter{}, nil
+	case "activitystreams":
+		return &ActivityStreamsImporter{}, nil
+	default:
+		return nil, fmt.Errorf("unknown import flavor: %s (want wikidata, csv, or activitystreams)", flavor)
+	}
+}
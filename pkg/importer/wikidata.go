@@ -0,0 +1,74 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// wikidataLabel is a Wikidata "labels"/"descriptions" entry in one language.
+type wikidataLabel struct {
+	Value string `json:"value"`
+}
+
+// wikidataEntity is the subset of a Wikidata entity JSON this importer reads.
+// It's meant to be fed a SPARQL-filtered dump (an array of entities for the
+// Q-IDs of interest), not a full Wikidata dump.
+type wikidataEntity struct {
+	ID           string                   `json:"id"`
+	Labels       map[string]wikidataLabel `json:"labels"`
+	Descriptions map[string]wikidataLabel `json:"descriptions"`
+	Claims       struct {
+		// P106 is "occupation"; P18 is "image". Both are reported by
+		// wikibase-cli/SPARQL exports as plain label strings rather than
+		// full claim objects, which is the shape this importer expects.
+		P106 []string `json:"P106"`
+		P18  []string `json:"P18"`
+	} `json:"claims"`
+}
+
+// WikidataImporter reads a JSON array of Wikidata entities (filtered to the
+// Q-IDs of interest ahead of time, e.g. via a SPARQL query) and converts
+// each into a Profile. Verdict is left blank — Wikidata has no concept of
+// one — so callers typically set it afterward or run the result back
+// through the scraper.
+type WikidataImporter struct{}
+
+func (w *WikidataImporter) Import(path string) ([]*models.Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read wikidata dump %s: %w", path, err)
+	}
+
+	var entities []wikidataEntity
+	if err := json.Unmarshal(data, &entities); err != nil {
+		return nil, fmt.Errorf("failed to parse wikidata dump %s: %w", path, err)
+	}
+
+	profiles := make([]*models.Profile, 0, len(entities))
+	for _, e := range entities {
+		label, ok := e.Labels["en"]
+		if !ok || label.Value == "" {
+			continue
+		}
+
+		profile := &models.Profile{
+			Name: label.Value,
+			URL:  fmt.Sprintf("https://www.wikidata.org/wiki/%s", e.ID),
+		}
+		if desc, ok := e.Descriptions["en"]; ok {
+			profile.Description = desc.Value
+		}
+		if len(e.Claims.P106) > 0 {
+			profile.Category = e.Claims.P106[0]
+		}
+		if len(e.Claims.P18) > 0 {
+			profile.ImageURL = e.Claims.P18[0]
+		}
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,460 @@
+// Package index builds an in-memory inverted index over profile text fields
+// and ranks matches with BM25, so Client.Search doesn't have to fall back
+// to an O(N) substring scan as the corpus grows. The client's
+// SearchProfiles/GetProfilesByVerdict/GetProfilesByCategory do not use the
+// index; they scan every profile. The index is rebuilt automatically as profiles are
+// loaded or added (see pkg/client) and can be persisted to disk so a
+// restart doesn't have to retokenize the whole corpus.
+package index
+
+import (
+	"encoding/gob"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// BM25 tuning constants (k1 controls term-frequency saturation, b controls
+// document-length normalization). These are the commonly recommended
+// defaults and aren't exposed as options since nothing in this codebase
+// needs to tune them per query.
+const (
+	k1 = 1.2
+	b  = 0.75
+)
+
+// fieldBoosts weights how much a field's BM25 score counts toward a
+// document's total score. Name and Category matches are much stronger
+// signals of relevance than a word buried in Description.
+var fieldBoosts = map[string]float64{
+	"name":        3,
+	"category":    2,
+	"description": 1,
+	"verdict":     1,
+	"facts":       1,
+}
+
+// fields lists the indexed fields, in the order fieldText understands them.
+var fields = []string{"name", "description", "category", "verdict", "facts"}
+
+// tokenRe splits field text into lowercase word tokens.
+var tokenRe = regexp.MustCompile(`[a-z0-9]+`)
+
+// SearchResult pairs a profile with its BM25 score for a particular query.
+type SearchResult struct {
+	Profile *models.Profile
+	Score   float64
+}
+
+// Index is an in-memory inverted index with BM25 scoring over a set of
+// profiles. The zero value is not usable; construct with New or Build.
+type Index struct {
+	mu sync.RWMutex
+
+	profiles map[string]*models.Profile // name -> profile
+
+	// postings[field][term][name] is how many times term appears in
+	// field for the named profile.
+	postings map[string]map[string]map[string]int
+
+	// docTerms[name][field][term] mirrors postings, indexed by document
+	// first, so Add can cheaply undo a document's old postings before
+	// reindexing it with fresh field text.
+	docTerms map[string]map[string]map[string]int
+
+	// fieldLen[field][name] is the token count of field for name, used
+	// for BM25's document-length normalization.
+	fieldLen map[string]map[string]int
+
+	// avgLen[field] is the average token count of field across all
+	// indexed documents.
+	avgLen map[string]float64
+}
+
+// New returns an empty Index.
+func New() *Index {
+	return &Index{
+		profiles: make(map[string]*models.Profile),
+		postings: make(map[string]map[string]map[string]int),
+		docTerms: make(map[string]map[string]map[string]int),
+		fieldLen: make(map[string]map[string]int),
+		avgLen:   make(map[string]float64),
+	}
+}
+
+// Build returns an Index populated from profiles.
+func Build(profiles []*models.Profile) *Index {
+	idx := New()
+	for _, p := range profiles {
+		idx.Add(p)
+	}
+	return idx
+}
+
+// Add inserts or reindexes profile. Calling it again for a name already in
+// the index replaces that document's postings with fresh ones derived from
+// the current field text.
+func (idx *Index) Add(profile *models.Profile) {
+	if profile == nil || profile.Name == "" {
+		return
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(profile.Name)
+	idx.profiles[profile.Name] = profile
+
+	for _, field := range fields {
+		tokens := tokenize(fieldText(profile, field))
+
+		freq := make(map[string]int, len(tokens))
+		for _, t := range tokens {
+			freq[t]++
+		}
+
+		if idx.postings[field] == nil {
+			idx.postings[field] = make(map[string]map[string]int)
+		}
+		if idx.fieldLen[field] == nil {
+			idx.fieldLen[field] = make(map[string]int)
+		}
+		if idx.docTerms[profile.Name] == nil {
+			idx.docTerms[profile.Name] = make(map[string]map[string]int)
+		}
+
+		idx.fieldLen[field][profile.Name] = len(tokens)
+		idx.docTerms[profile.Name][field] = freq
+		for term, f := range freq {
+			if idx.postings[field][term] == nil {
+				idx.postings[field][term] = make(map[string]int)
+			}
+			idx.postings[field][term][profile.Name] = f
+		}
+	}
+
+	idx.recalculateAvgLenLocked()
+}
+
+// Remove drops name from the index, if present.
+func (idx *Index) Remove(name string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(name)
+	idx.recalculateAvgLenLocked()
+}
+
+// removeLocked deletes name's postings and profile entry. Callers must hold
+// idx.mu for writing.
+func (idx *Index) removeLocked(name string) {
+	for field, terms := range idx.docTerms[name] {
+		for term := range terms {
+			delete(idx.postings[field][term], name)
+			if len(idx.postings[field][term]) == 0 {
+				delete(idx.postings[field], term)
+			}
+		}
+		delete(idx.fieldLen[field], name)
+	}
+	delete(idx.docTerms, name)
+	delete(idx.profiles, name)
+}
+
+// recalculateAvgLenLocked recomputes the average field length used for BM25
+// length normalization. Callers must hold idx.mu for writing.
+func (idx *Index) recalculateAvgLenLocked() {
+	total := len(idx.profiles)
+	for _, field := range fields {
+		if total == 0 {
+			idx.avgLen[field] = 0
+			continue
+		}
+		sum := 0
+		for _, length := range idx.fieldLen[field] {
+			sum += length
+		}
+		idx.avgLen[field] = float64(sum) / float64(total)
+	}
+}
+
+// tokenize lowercases s and splits it into word tokens.
+func tokenize(s string) []string {
+	return tokenRe.FindAllString(strings.ToLower(s), -1)
+}
+
+// fieldText returns the raw text backing an indexed field. "facts" combines
+// Pros and Cons, since the backlog's Fact-sheet concept maps onto those two
+// slices on models.Profile.
+func fieldText(p *models.Profile, field string) string {
+	switch field {
+	case "name":
+		return p.Name
+	case "description":
+		return p.Description
+	case "category":
+		return p.Category
+	case "verdict":
+		return p.Verdict
+	case "facts":
+		return strings.Join(p.Pros, " ") + " " + strings.Join(p.Cons, " ")
+	default:
+		return ""
+	}
+}
+
+// Search tokenizes query and returns up to limit profiles ranked by BM25
+// score, descending. Supported query syntax:
+//
+//   - "quoted phrases" match documents containing the phrase verbatim
+//     (case-insensitive) in any indexed field.
+//   - field:value restricts results to documents where field's full text
+//     equals value exactly (case-insensitive, whitespace-normalized), e.g.
+//     `category:music verdict:jew` — a token-containment check would also
+//     match "Not a Jew" against `verdict:jew`, since it contains the word
+//     "jew" too.
+//   - trailing asterisks do prefix matching, e.g. `einst*`.
+//   - anything else is a plain term, scored with BM25 across all fields
+//     with the field's boost applied (see fieldBoosts).
+//
+// A query with no free terms or prefixes (filters/phrases only) scores
+// every matching document 1.0 and returns them in name order.
+func (idx *Index) Search(query string, limit int) []SearchResult {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	filters, phrases, terms := parseQuery(query)
+
+	candidates := make(map[string]*models.Profile, len(idx.profiles))
+	for name, p := range idx.profiles {
+		candidates[name] = p
+	}
+
+	for field, value := range filters {
+		idx.filterLocked(candidates, field, value)
+	}
+	for _, phrase := range phrases {
+		idx.filterPhraseLocked(candidates, phrase)
+	}
+
+	scores := make(map[string]float64, len(candidates))
+	for name := range candidates {
+		scores[name] = 0
+	}
+
+	scored := false
+	for _, term := range terms {
+		scored = true
+		if strings.HasSuffix(term, "*") {
+			idx.scorePrefixLocked(candidates, scores, strings.TrimSuffix(term, "*"))
+		} else {
+			idx.scoreTermLocked(candidates, scores, term)
+		}
+	}
+
+	results := make([]SearchResult, 0, len(candidates))
+	for name, p := range candidates {
+		score := scores[name]
+		if scored && score <= 0 {
+			continue
+		}
+		if !scored {
+			score = 1
+		}
+		results = append(results, SearchResult{Profile: p, Score: score})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Profile.Name < results[j].Profile.Name
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// filterLocked narrows candidates to documents whose field's full text
+// tokenizes to exactly value's tokens — not merely a document containing
+// every token of value, which would let a query like verdict:jew match a
+// "Not a Jew" verdict (it contains the token "jew" too). Callers must hold
+// idx.mu for reading.
+func (idx *Index) filterLocked(candidates map[string]*models.Profile, field, value string) {
+	want := strings.Join(tokenize(value), " ")
+	for name, p := range candidates {
+		if strings.Join(tokenize(fieldText(p, field)), " ") != want {
+			delete(candidates, name)
+		}
+	}
+}
+
+// filterPhraseLocked narrows candidates to documents containing phrase
+// verbatim in at least one indexed field. Callers must hold idx.mu for
+// reading.
+func (idx *Index) filterPhraseLocked(candidates map[string]*models.Profile, phrase string) {
+	for name, p := range candidates {
+		found := false
+		for _, field := range fields {
+			if strings.Contains(strings.ToLower(fieldText(p, field)), phrase) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			delete(candidates, name)
+		}
+	}
+}
+
+// scoreTermLocked adds term's boosted BM25 contribution, across all fields,
+// to scores for every name in candidates. Callers must hold idx.mu for
+// reading.
+func (idx *Index) scoreTermLocked(candidates map[string]*models.Profile, scores map[string]float64, term string) {
+	for _, field := range fields {
+		postings := idx.postings[field][term]
+		if len(postings) == 0 {
+			continue
+		}
+		idfVal := idx.idfLocked(len(postings))
+		boost := fieldBoosts[field]
+		avg := idx.avgLen[field]
+		for name, freq := range postings {
+			if _, ok := candidates[name]; !ok {
+				continue
+			}
+			scores[name] += boost * bm25(idfVal, float64(freq), float64(idx.fieldLen[field][name]), avg)
+		}
+	}
+}
+
+// scorePrefixLocked expands prefix to every vocabulary term starting with
+// it, across all fields, and sums each one's boosted BM25 contribution into
+// scores. Callers must hold idx.mu for reading.
+func (idx *Index) scorePrefixLocked(candidates map[string]*models.Profile, scores map[string]float64, prefix string) {
+	if prefix == "" {
+		return
+	}
+	// scoreTermLocked already covers every field, so each term is scored
+	// once even when it appears in several fields' vocabularies.
+	seen := make(map[string]bool)
+	for _, field := range fields {
+		for term := range idx.postings[field] {
+			if strings.HasPrefix(term, prefix) && !seen[term] {
+				seen[term] = true
+				idx.scoreTermLocked(candidates, scores, term)
+			}
+		}
+	}
+}
+
+// idfLocked returns the inverse document frequency of a term that appears
+// in df documents, using the BM25 IDF variant that stays non-negative for
+// common terms. Callers must hold idx.mu for reading.
+func (idx *Index) idfLocked(df int) float64 {
+	n := float64(len(idx.profiles))
+	return math.Log(1 + (n-float64(df)+0.5)/(float64(df)+0.5))
+}
+
+// bm25 scores a single term/field/document combination.
+func bm25(idfVal, freq, docLen, avgLen float64) float64 {
+	if avgLen == 0 {
+		avgLen = docLen
+	}
+	norm := 1 - b + b*docLen/avgLen
+	return idfVal * (freq * (k1 + 1)) / (freq + k1*norm)
+}
+
+// filterValueRe matches a field:value query token.
+var filterValueRe = regexp.MustCompile(`^([a-zA-Z]+):(.+)$`)
+
+// parseQuery splits query into field:value filters, "quoted phrases", and
+// remaining free terms (including trailing-* prefixes), all lowercased.
+func parseQuery(query string) (filters map[string]string, phrases []string, terms []string) {
+	filters = make(map[string]string)
+
+	phraseRe := regexp.MustCompile(`"([^"]*)"`)
+	remaining := phraseRe.ReplaceAllStringFunc(query, func(m string) string {
+		sub := phraseRe.FindStringSubmatch(m)
+		if phrase := strings.ToLower(strings.TrimSpace(sub[1])); phrase != "" {
+			phrases = append(phrases, phrase)
+		}
+		return " "
+	})
+
+	for _, tok := range strings.Fields(remaining) {
+		if m := filterValueRe.FindStringSubmatch(tok); m != nil {
+			if _, known := fieldBoosts[strings.ToLower(m[1])]; known {
+				filters[strings.ToLower(m[1])] = strings.ToLower(m[2])
+				continue
+			}
+		}
+		terms = append(terms, strings.ToLower(tok))
+	}
+
+	return filters, phrases, terms
+}
+
+// snapshot is the gob-serializable form of an Index, used by Save/Load.
+type snapshot struct {
+	Profiles map[string]*models.Profile
+	Postings map[string]map[string]map[string]int
+	DocTerms map[string]map[string]map[string]int
+	FieldLen map[string]map[string]int
+	AvgLen   map[string]float64
+}
+
+// Save persists the index to path so Load can reconstruct it without
+// retokenizing the corpus.
+func (idx *Index) Save(path string) error {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create index file: %w", err)
+	}
+	defer f.Close()
+
+	snap := snapshot{
+		Profiles: idx.profiles,
+		Postings: idx.postings,
+		DocTerms: idx.docTerms,
+		FieldLen: idx.fieldLen,
+		AvgLen:   idx.avgLen,
+	}
+	if err := gob.NewEncoder(f).Encode(&snap); err != nil {
+		return fmt.Errorf("failed to encode index: %w", err)
+	}
+	return nil
+}
+
+// Load reconstructs an Index previously written by Save.
+func Load(path string) (*Index, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open index file: %w", err)
+	}
+	defer f.Close()
+
+	var snap snapshot
+	if err := gob.NewDecoder(f).Decode(&snap); err != nil {
+		return nil, fmt.Errorf("failed to decode index: %w", err)
+	}
+
+	return &Index{
+		profiles: snap.Profiles,
+		postings: snap.Postings,
+		docTerms: snap.DocTerms,
+		fieldLen: snap.FieldLen,
+		avgLen:   snap.AvgLen,
+	}, nil
+}
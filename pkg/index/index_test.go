@@ -0,0 +1,198 @@
+package index
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+func testProfiles() []*models.Profile {
+	return []*models.Profile{
+		{
+			Name:        "Albert Einstein",
+			Description: "theoretical physicist who developed the theory of relativity",
+			Category:    "Science",
+			Verdict:     "Jew",
+			Pros:        []string{"Born to Jewish parents"},
+			Cons:        []string{"Non-observant"},
+		},
+		{
+			Name:        "Adam Sandler",
+			Description: "actor and comedian known for comedy films",
+			Category:    "Entertainment",
+			Verdict:     "Jew",
+			Pros:        []string{"Bar Mitzvah'd", "Created the Chanukah Song"},
+		},
+		{
+			Name:        "Madonna",
+			Description: "singer and pop star known for reinvention",
+			Category:    "Music",
+			Verdict:     "Not a Jew",
+			Pros:        []string{"Practices Kabbalah"},
+		},
+	}
+}
+
+func names(results []SearchResult) []string {
+	out := make([]string, len(results))
+	for i, r := range results {
+		out[i] = r.Profile.Name
+	}
+	return out
+}
+
+func TestSearchPlainTermScoresAcrossFields(t *testing.T) {
+	idx := Build(testProfiles())
+
+	results := idx.Search("physicist", 0)
+	if len(results) != 1 || results[0].Profile.Name != "Albert Einstein" {
+		t.Fatalf("Search(physicist) = %v, want only Albert Einstein", names(results))
+	}
+	if results[0].Score <= 0 {
+		t.Errorf("Search(physicist) score = %v, want > 0", results[0].Score)
+	}
+}
+
+func TestSearchNoMatchingTermReturnsEmpty(t *testing.T) {
+	idx := Build(testProfiles())
+
+	if results := idx.Search("nonexistentword", 0); len(results) != 0 {
+		t.Errorf("Search(nonexistentword) = %v, want no results", names(results))
+	}
+}
+
+func TestSearchFieldFilter(t *testing.T) {
+	idx := Build(testProfiles())
+
+	results := idx.Search("category:music", 0)
+	if got := names(results); !reflect.DeepEqual(got, []string{"Madonna"}) {
+		t.Errorf("Search(category:music) = %v, want [Madonna]", got)
+	}
+}
+
+func TestSearchQuotedPhrase(t *testing.T) {
+	idx := Build(testProfiles())
+
+	results := idx.Search(`"theory of relativity"`, 0)
+	if got := names(results); !reflect.DeepEqual(got, []string{"Albert Einstein"}) {
+		t.Errorf(`Search("theory of relativity") = %v, want [Albert Einstein]`, got)
+	}
+}
+
+func TestSearchPrefixMatch(t *testing.T) {
+	idx := Build(testProfiles())
+
+	results := idx.Search("phys*", 0)
+	if got := names(results); !reflect.DeepEqual(got, []string{"Albert Einstein"}) {
+		t.Errorf("Search(phys*) = %v, want [Albert Einstein]", got)
+	}
+}
+
+func TestSearchFiltersOnlyReturnsEveryMatchWithScoreOne(t *testing.T) {
+	idx := Build(testProfiles())
+
+	results := idx.Search("verdict:jew", 0)
+	got := names(results)
+	if len(got) != 2 {
+		t.Fatalf("Search(verdict:jew) = %v, want 2 results", got)
+	}
+	for _, r := range results {
+		if r.Score != 1 {
+			t.Errorf("Search(verdict:jew) score for %s = %v, want 1 (filter-only query)", r.Profile.Name, r.Score)
+		}
+	}
+}
+
+func TestSearchNameBoostOutranksDescriptionMatch(t *testing.T) {
+	idx := Build([]*models.Profile{
+		{Name: "Somebody Jazz", Description: "an unrelated person"},
+		{Name: "Nobody Notable", Description: "a jazz musician"},
+	})
+
+	// "jazz" matches one profile by Name and the other only by
+	// Description; fieldBoosts weighs a Name hit three times a
+	// Description hit, so the Name match should rank first.
+	results := idx.Search("jazz", 0)
+	if got := names(results); len(got) != 2 || got[0] != "Somebody Jazz" {
+		t.Errorf("Search(jazz) = %v, want Somebody Jazz ranked first", got)
+	}
+}
+
+func TestSearchLimit(t *testing.T) {
+	idx := Build(testProfiles())
+
+	results := idx.Search("verdict:jew", 1)
+	if len(results) != 1 {
+		t.Errorf("Search(verdict:jew, limit=1) returned %d results, want 1", len(results))
+	}
+}
+
+func TestIndexRemove(t *testing.T) {
+	idx := Build(testProfiles())
+
+	idx.Remove("Albert Einstein")
+
+	if results := idx.Search("physicist", 0); len(results) != 0 {
+		t.Errorf("Search(physicist) after Remove(Albert Einstein) = %v, want no results", names(results))
+	}
+	if results := idx.Search("name:einstein", 0); len(results) != 0 {
+		t.Errorf("Search(name:einstein) after Remove(Albert Einstein) = %v, want no results", names(results))
+	}
+}
+
+func TestIndexAddReplacesExistingDocument(t *testing.T) {
+	idx := Build(testProfiles())
+
+	updated := &models.Profile{Name: "Albert Einstein", Description: "a chess player", Category: "Games"}
+	idx.Add(updated)
+
+	if results := idx.Search("physicist", 0); len(results) != 0 {
+		t.Errorf("Search(physicist) after reindexing Albert Einstein = %v, want no results (old text gone)", names(results))
+	}
+	if results := idx.Search("category:games", 0); len(names(results)) != 1 {
+		t.Errorf("Search(category:games) after reindexing = %v, want [Albert Einstein]", names(results))
+	}
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	idx := Build(testProfiles())
+	path := filepath.Join(t.TempDir(), "index.gob")
+
+	if err := idx.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	got := names(loaded.Search("physicist", 0))
+	want := names(idx.Search("physicist", 0))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Search(physicist) on loaded index = %v, want %v", got, want)
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	filters, phrases, terms := parseQuery(`category:music "pop star" einst*`)
+
+	if want := map[string]string{"category": "music"}; !reflect.DeepEqual(filters, want) {
+		t.Errorf("parseQuery() filters = %v, want %v", filters, want)
+	}
+	if want := []string{"pop star"}; !reflect.DeepEqual(phrases, want) {
+		t.Errorf("parseQuery() phrases = %v, want %v", phrases, want)
+	}
+	if want := []string{"einst*"}; !reflect.DeepEqual(terms, want) {
+		t.Errorf("parseQuery() terms = %v, want %v", terms, want)
+	}
+}
+
+func TestParseQueryUnknownFieldIsTreatedAsTerm(t *testing.T) {
+	_, _, terms := parseQuery("notafield:value")
+	if want := []string{"notafield:value"}; !reflect.DeepEqual(terms, want) {
+		t.Errorf("parseQuery(notafield:value) terms = %v, want %v", terms, want)
+	}
+}
@@ -0,0 +1,88 @@
+// Package mastoapi maps models.Profile onto Mastodon's Account entity, so
+// existing Mastodon client libraries can browse the profile dataset
+// without a bespoke integration. It pairs with pkg/activitypub: the same
+// profile is reachable as an ActivityPub actor and as a Mastodon account.
+package mastoapi
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"html"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// Field is a Mastodon profile metadata field, rendered the same way
+// PropertyValue attachments are in pkg/activitypub.
+type Field struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Account is a models.Profile rendered as a (trimmed) Mastodon Account
+// entity: only the fields a client needs to display and look up a
+// profile are included, not Mastodon's full follower-count/relationship
+// surface, which has no equivalent in this dataset.
+type Account struct {
+	Id           string  `json:"id"`
+	Username     string  `json:"username"`
+	Acct         string  `json:"acct"`
+	DisplayName  string  `json:"display_name"`
+	Note         string  `json:"note"`
+	URL          string  `json:"url"`
+	Avatar       string  `json:"avatar"`
+	AvatarStatic string  `json:"avatar_static"`
+	CreatedAt    string  `json:"created_at"`
+	Fields       []Field `json:"fields"`
+}
+
+// AccountID returns a stable, Mastodon-shaped numeric account id derived
+// from profileURL, so a profile always maps to the same id across
+// requests without needing a dedicated id column.
+func AccountID(profileURL string) string {
+	sum := sha256.Sum256([]byte(profileURL))
+	return strconv.FormatUint(binary.BigEndian.Uint64(sum[:8]), 10)
+}
+
+// Slug lowercases name and collapses every run of non-alphanumeric
+// characters into a single underscore, matching the handle shape
+// Mastodon's username field expects.
+func Slug(name string) string {
+	var b strings.Builder
+	lastUnderscore := false
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+			lastUnderscore = false
+		case !lastUnderscore:
+			b.WriteByte('_')
+			lastUnderscore = true
+		}
+	}
+	return strings.Trim(b.String(), "_")
+}
+
+// BuildAccount renders profile as an Account.
+func BuildAccount(profile *models.Profile) *Account {
+	slug := Slug(profile.Name)
+	return &Account{
+		Id:           AccountID(profile.URL),
+		Username:     slug,
+		Acct:         slug,
+		DisplayName:  profile.Name,
+		Note:         "<p>" + html.EscapeString(profile.Description) + "</p>",
+		URL:          profile.URL,
+		Avatar:       profile.ImageURL,
+		AvatarStatic: profile.ImageURL,
+		CreatedAt:    profile.CreatedAt,
+		Fields: []Field{
+			{Name: "Verdict", Value: profile.Verdict},
+			{Name: "Category", Value: profile.Category},
+			{Name: "Score", Value: strconv.FormatFloat(profile.Score, 'f', -1, 64)},
+		},
+	}
+}
@@ -0,0 +1,108 @@
+// Package metrics instruments long-running scrapes with Prometheus
+// counters/gauges and exposes them, alongside net/http/pprof, over an
+// optional HTTP server so operators running the scraper as a scheduled job
+// have more than a progress bar and a log file to watch.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics holds the Prometheus collectors instrumenting a scrape run.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	// ProfilesTotal counts profiles processed by ScrapeAll, labeled by
+	// outcome ("new", "updated", "skipped", "failed").
+	ProfilesTotal *prometheus.CounterVec
+
+	// HTTPRequestDuration tracks the latency of profile fetch requests.
+	HTTPRequestDuration prometheus.Histogram
+
+	// InFlightRequests is the number of profile fetch requests currently
+	// in flight.
+	InFlightRequests prometheus.Gauge
+
+	// HTTPStatusTotal counts profile fetch responses, labeled by HTTP
+	// status code.
+	HTTPStatusTotal *prometheus.CounterVec
+}
+
+// New returns a Metrics bundle registered on its own registry, so scraping
+// it doesn't also pull in the default process/Go runtime collectors.
+func New() *Metrics {
+	registry := prometheus.NewRegistry()
+
+	m := &Metrics{
+		registry: registry,
+		ProfilesTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "scraper_profiles_total",
+			Help: "Profiles processed by ScrapeAll, by outcome.",
+		}, []string{"result"}),
+		HTTPRequestDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "scraper_http_request_duration_seconds",
+			Help:    "Latency of profile fetch requests.",
+			Buckets: prometheus.DefBuckets,
+		}),
+		InFlightRequests: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "scraper_in_flight_requests",
+			Help: "Profile fetch requests currently in flight.",
+		}),
+		HTTPStatusTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "scraper_http_status_total",
+			Help: "Profile fetch responses, by HTTP status code.",
+		}, []string{"code"}),
+	}
+
+	registry.MustRegister(m.ProfilesTotal, m.HTTPRequestDuration, m.InFlightRequests, m.HTTPStatusTotal)
+	return m
+}
+
+// ObserveRequest records the outcome of a single profile fetch: duration is
+// the request's wall-clock time, and statusCode is the HTTP status returned
+// (pass 0 if the request never got a response, e.g. a network error).
+func (m *Metrics) ObserveRequest(duration time.Duration, statusCode int) {
+	m.HTTPRequestDuration.Observe(duration.Seconds())
+	if statusCode > 0 {
+		m.HTTPStatusTotal.WithLabelValues(strconv.Itoa(statusCode)).Inc()
+	}
+}
+
+// Serve starts an HTTP server on addr exposing the registered metrics at
+// /metrics and net/http/pprof handlers under /debug/pprof/ for heap and
+// goroutine inspection during long crawls. It blocks until ctx is cancelled
+// or the server fails, so callers should run it in its own goroutine.
+func (m *Metrics) Serve(ctx context.Context, addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	server := &http.Server{Addr: addr, Handler: mux}
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- server.ListenAndServe() }()
+
+	select {
+	case <-ctx.Done():
+		server.Close()
+		return nil
+	case err := <-errCh:
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("metrics server failed: %w", err)
+		}
+		return nil
+	}
+}
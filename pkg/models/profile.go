@@ -13,4 +13,20 @@ type Profile struct {
 	ImageURL    string   `json:"image_url"`
 	CreatedAt   string   `json:"created_at"`
 	UpdatedAt   string   `json:"updated_at"`
+
+	// ImageLocal, ImageMIME, ImageSHA256, and Thumbnails are filled in by
+	// pkg/assets once ImageURL has been fetched and cached locally, or when
+	// pkg/server saves an image uploaded with a multipart profile. Otherwise
+	// they stay empty. See Client.RehydrateImages for backfilling
+	// profiles saved before pkg/assets existed.
+	ImageLocal  string            `json:"image_local"`
+	ImageMIME   string            `json:"image_mime"`
+	ImageSHA256 string            `json:"image_sha256"`
+	Thumbnails  map[string]string `json:"thumbnails"`
+
+	// CategoryConfidence is how confident pkg/classify's Naive Bayes
+	// classifier was in Category, from 0 to 1. It's 0 for profiles whose
+	// Category came from HTML extraction or the keyword fallback rather
+	// than the classifier. See Client.Reclassify.
+	CategoryConfidence float64 `json:"category_confidence"`
 }
\ No newline at end of file
@@ -0,0 +1,14 @@
+package models
+
+// Webmention is an incoming mention of a profile page discovered by
+// parsing the microformats2 h-entry at SourceURL. See pkg/api's
+// /webmention endpoint and the webmentions table in pkg/db.
+type Webmention struct {
+	SourceURL   string `json:"source_url"`
+	Type        string `json:"type"` // like, reply, repost, or mention
+	AuthorName  string `json:"author_name"`
+	AuthorPhoto string `json:"author_photo"`
+	Content     string `json:"content"`
+	PublishedAt string `json:"published_at"`
+	CreatedAt   string `json:"created_at"`
+}
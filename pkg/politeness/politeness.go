@@ -0,0 +1,321 @@
+// Package politeness makes the scraper a good citizen of whatever site it
+// points at: it caches and honors robots.txt, rate-limits per host with a
+// token bucket, and retries transient failures with exponential backoff.
+package politeness
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Policy bundles the politeness settings used to wrap an *http.Client's
+// requests: robots.txt compliance, per-host rate limiting, and retry/backoff
+// on transient errors.
+type Policy struct {
+	UserAgent        string
+	MaxRetries       int
+	RequestsPerSecond float64
+	RespectRobots    bool
+
+	mu        sync.Mutex
+	limiters  map[string]*tokenBucket
+	robotsTxt map[string]*robotsRules
+}
+
+// NewPolicy returns a Policy with the given settings.
+func NewPolicy(userAgent string, maxRetries int, requestsPerSecond float64, respectRobots bool) *Policy {
+	return &Policy{
+		UserAgent:         userAgent,
+		MaxRetries:        maxRetries,
+		RequestsPerSecond: requestsPerSecond,
+		RespectRobots:     respectRobots,
+		limiters:          make(map[string]*tokenBucket),
+		robotsTxt:         make(map[string]*robotsRules),
+	}
+}
+
+// Allowed reports whether robots.txt (if RespectRobots is set) permits
+// fetching rawURL, fetching and caching the robots.txt for that host with
+// client on first use.
+func (p *Policy) Allowed(ctx context.Context, client *http.Client, rawURL string) (bool, error) {
+	if !p.RespectRobots {
+		return true, nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse URL %s: %w", rawURL, err)
+	}
+
+	rules, err := p.robots(ctx, client, u)
+	if err != nil {
+		// Fail open: an unreachable robots.txt shouldn't block the crawl.
+		return true, nil
+	}
+
+	return rules.allows(u.Path), nil
+}
+
+// Wait blocks until the per-host token bucket for rawURL's host has a token
+// available, then consumes it.
+func (p *Policy) Wait(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse URL %s: %w", rawURL, err)
+	}
+
+	return p.limiterFor(u.Host).Wait(ctx)
+}
+
+// crawlDelay returns any Crawl-delay robots.txt set for host, or 0.
+func (p *Policy) crawlDelay(host string) time.Duration {
+	p.mu.Lock()
+	rules := p.robotsTxt[host]
+	p.mu.Unlock()
+	if rules == nil {
+		return 0
+	}
+	return rules.crawlDelay
+}
+
+func (p *Policy) limiterFor(host string) *tokenBucket {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	rate := p.RequestsPerSecond
+	if rate <= 0 {
+		rate = 1
+	}
+	if delay := p.robotsTxt[host]; delay != nil && delay.crawlDelay > 0 {
+		if perSecond := 1 / delay.crawlDelay.Seconds(); perSecond < rate {
+			rate = perSecond
+		}
+	}
+
+	lim, ok := p.limiters[host]
+	if !ok {
+		lim = newTokenBucket(rate)
+		p.limiters[host] = lim
+	}
+	return lim
+}
+
+func (p *Policy) robots(ctx context.Context, client *http.Client, u *url.URL) (*robotsRules, error) {
+	p.mu.Lock()
+	if rules, ok := p.robotsTxt[u.Host]; ok {
+		p.mu.Unlock()
+		return rules, nil
+	}
+	p.mu.Unlock()
+
+	robotsURL := fmt.Sprintf("%s://%s/robots.txt", u.Scheme, u.Host)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if p.UserAgent != "" {
+		req.Header.Set("User-Agent", p.UserAgent)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := parseRobots(string(body))
+
+	p.mu.Lock()
+	p.robotsTxt[u.Host] = rules
+	p.mu.Unlock()
+
+	return rules, nil
+}
+
+// Do performs req with client, retrying transient failures (network errors
+// and 429/502/503/504 responses) with exponential backoff and jitter, up to
+// MaxRetries attempts. A Retry-After response header is honored when present.
+func (p *Policy) Do(ctx context.Context, client *http.Client, req *http.Request) (*http.Response, error) {
+	if p.UserAgent != "" {
+		req.Header.Set("User-Agent", p.UserAgent)
+	}
+
+	maxRetries := p.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = 1
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		if attempt > 0 {
+			delay := backoffDelay(attempt)
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		resp, err := client.Do(req.Clone(ctx))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if !isRetryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+
+		retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+		resp.Body.Close()
+		lastErr = fmt.Errorf("received retryable status %d", resp.StatusCode)
+
+		if retryAfter > 0 {
+			select {
+			case <-time.After(retryAfter):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("request failed after %d attempts: %w", maxRetries, lastErr)
+}
+
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if when, err := http.ParseTime(header); err == nil {
+		return time.Until(when)
+	}
+	return 0
+}
+
+// backoffDelay returns an exponential backoff with jitter for the given
+// (1-indexed) retry attempt: base 200ms, doubling each attempt, with the
+// actual wait drawn at random between half and all of that value.
+func backoffDelay(attempt int) time.Duration {
+	base := 200 * time.Millisecond
+	delay := base << uint(attempt-1)
+	jitter := time.Duration(rand.Int63n(int64(delay) / 2))
+	return delay/2 + jitter
+}
+
+// robotsRules is a minimal parsed robots.txt: the Disallow paths and
+// Crawl-delay from the "User-agent: *" group (see parseRobots).
+type robotsRules struct {
+	disallow   []string
+	crawlDelay time.Duration
+}
+
+func (r *robotsRules) allows(path string) bool {
+	for _, prefix := range r.disallow {
+		if prefix != "" && strings.HasPrefix(path, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseRobots does a best-effort parse of a robots.txt body, honoring only
+// the "User-agent: *" group (this scraper doesn't claim a reserved UA).
+func parseRobots(body string) *robotsRules {
+	rules := &robotsRules{}
+	inWildcardGroup := false
+
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		directive := strings.ToLower(strings.TrimSpace(parts[0]))
+		value := strings.TrimSpace(parts[1])
+
+		switch directive {
+		case "user-agent":
+			inWildcardGroup = value == "*"
+		case "disallow":
+			if inWildcardGroup && value != "" {
+				rules.disallow = append(rules.disallow, value)
+			}
+		case "crawl-delay":
+			if inWildcardGroup {
+				if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+					rules.crawlDelay = time.Duration(seconds * float64(time.Second))
+				}
+			}
+		}
+	}
+
+	return rules
+}
+
+// tokenBucket is a simple per-host rate limiter.
+type tokenBucket struct {
+	mu       sync.Mutex
+	rate     float64 // tokens per second
+	tokens   float64
+	lastFill time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	return &tokenBucket{rate: rate, tokens: 1, lastFill: time.Now()}
+}
+
+// Wait blocks until a token is available or ctx is cancelled.
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens += now.Sub(b.lastFill).Seconds() * b.rate
+		if b.tokens > 1 {
+			b.tokens = 1
+		}
+		b.lastFill = now
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
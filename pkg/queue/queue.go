@@ -0,0 +1,132 @@
+// Package queue implements a persistent, resumable visit queue for the
+// scraper: a BoltDB-backed set of profile IDs keyed by crawl state, so a
+// killed scrape can pick back up instead of rescanning from ID 1.
+package queue
+
+import (
+	"fmt"
+	"strconv"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// State is the crawl status of one profile ID.
+type State byte
+
+const (
+	// Pending has not been attempted yet.
+	Pending State = iota
+	// InFlight is currently being fetched; on restart these are re-enqueued
+	// as Pending since we can't know whether the fetch completed.
+	InFlight
+	// Success was fetched and parsed into a profile.
+	Success
+	// NotFound was fetched but yielded an empty or placeholder profile.
+	NotFound
+	// FailedRetryable hit a fetch or parse error (network, any non-200
+	// response) and is retried on the next resume.
+	FailedRetryable
+)
+
+var bucketName = []byte("visits")
+
+// Queue is a BoltDB-backed visit queue keyed by profile ID.
+type Queue struct {
+	db *bolt.DB
+}
+
+// Open opens (creating if necessary) the visit queue database at path.
+func Open(path string) (*Queue, error) {
+	db, err := bolt.Open(path, 0644, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open visit queue %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketName)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to init visit queue bucket: %w", err)
+	}
+
+	return &Queue{db: db}, nil
+}
+
+// Close closes the underlying database.
+func (q *Queue) Close() error {
+	return q.db.Close()
+}
+
+// Enqueue marks id as Pending if it has no recorded state yet. It is a no-op
+// for IDs that have already been visited or are in flight.
+func (q *Queue) Enqueue(id int) error {
+	return q.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketName)
+		key := idKey(id)
+		if b.Get(key) != nil {
+			return nil
+		}
+		return b.Put(key, []byte{byte(Pending)})
+	})
+}
+
+// SetState records state for id.
+func (q *Queue) SetState(id int, state State) error {
+	return q.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketName).Put(idKey(id), []byte{byte(state)})
+	})
+}
+
+// State returns the recorded state for id, defaulting to Pending if unseen.
+func (q *Queue) State(id int) State {
+	var state State
+	_ = q.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(bucketName).Get(idKey(id))
+		if len(v) == 1 {
+			state = State(v[0])
+		}
+		return nil
+	})
+	return state
+}
+
+// ResumeIDs returns every ID that still needs work: those recorded Pending,
+// FailedRetryable, or InFlight (re-enqueued as Pending, since an in-flight
+// entry's outcome from a killed run is unknown). Call this once after Open
+// to rebuild the work list for a resumed scrape.
+func (q *Queue) ResumeIDs() ([]int, error) {
+	var ids []int
+
+	err := q.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketName)
+		return b.ForEach(func(k, v []byte) error {
+			if len(v) != 1 {
+				return nil
+			}
+			state := State(v[0])
+			if state == InFlight {
+				// Unknown outcome from a killed run: re-enqueue as pending.
+				state = Pending
+				if err := b.Put(k, []byte{byte(Pending)}); err != nil {
+					return err
+				}
+			}
+			if state == Pending || state == FailedRetryable {
+				id, err := strconv.Atoi(string(k))
+				if err != nil {
+					return nil
+				}
+				ids = append(ids, id)
+			}
+			return nil
+		})
+	})
+
+	return ids, err
+}
+
+func idKey(id int) []byte {
+	return []byte(strconv.Itoa(id))
+}
@@ -0,0 +1,310 @@
+// Package server exposes a Micropub-style HTTP ingest API in front of
+// pkg/client: a single POST /profiles endpoint accepts JSON, form, or
+// multipart bodies (the same way hugo-micropub's CreateEntry dispatches on
+// Content-Type), alongside read-only GET endpoints for listing, fetching,
+// searching, and filtering profiles. A bearer-token middleware gates the
+// write path, and an ETag layer lets read traffic be cached, so the same
+// server can back both a public read-only mirror and a moderated ingest
+// path for user-contributed profiles.
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/marcusziade/jewornotjew/pkg/assets"
+	"github.com/marcusziade/jewornotjew/pkg/client"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// Server is the Micropub-style ingest/read API described in the package
+// doc comment.
+type Server struct {
+	client    *client.Client
+	authToken string
+	router    *mux.Router
+}
+
+// NewServer returns a Server backed by c. authToken, if non-empty, is the
+// bearer token POST /profiles requires via "Authorization: Bearer <token>";
+// an empty authToken leaves the write path open, which is only appropriate
+// behind a trusted proxy or in local development.
+func NewServer(c *client.Client, authToken string) *Server {
+	s := &Server{client: c, authToken: authToken, router: mux.NewRouter()}
+	s.routes()
+	return s
+}
+
+// routes sets up the routes for the server.
+func (s *Server) routes() {
+	s.router.HandleFunc("/profiles", withETag(s.listProfiles)).Methods("GET")
+	s.router.HandleFunc("/profiles", s.withAuth(s.createProfile)).Methods("POST")
+	s.router.HandleFunc("/profiles/{name}", withETag(s.getProfile)).Methods("GET")
+	s.router.HandleFunc("/search", withETag(s.searchProfiles)).Methods("GET")
+	s.router.HandleFunc("/category/{cat}", withETag(s.byCategory)).Methods("GET")
+	s.router.HandleFunc("/verdict/{v}", withETag(s.byVerdict)).Methods("GET")
+}
+
+// ServeHTTP implements the http.Handler interface
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.router.ServeHTTP(w, r)
+}
+
+// ListenAndServe starts the server.
+func (s *Server) ListenAndServe(addr string) error {
+	log.Printf("Ingest server listening on %s", addr)
+	return http.ListenAndServe(addr, s)
+}
+
+// withAuth rejects requests whose Authorization header doesn't carry the
+// configured bearer token, when one is configured. See NewServer.
+func (s *Server) withAuth(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.authToken != "" && r.Header.Get("Authorization") != "Bearer "+s.authToken {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// listProfiles handles GET /profiles
+func (s *Server) listProfiles(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.client.ListProfiles())
+}
+
+// getProfile handles GET /profiles/{name}
+func (s *Server) getProfile(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	profile, err := s.client.GetProfile(name)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("profile not found: %s", name), http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, profile)
+}
+
+// searchProfiles handles GET /search?q=query
+func (s *Server) searchProfiles(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		http.Error(w, "query parameter 'q' is required", http.StatusBadRequest)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.client.Search(query, 50))
+}
+
+// byCategory handles GET /category/{cat}
+func (s *Server) byCategory(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.client.GetProfilesByCategory(mux.Vars(r)["cat"]))
+}
+
+// byVerdict handles GET /verdict/{v}
+func (s *Server) byVerdict(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.client.GetProfilesByVerdict(mux.Vars(r)["v"]))
+}
+
+// createProfile handles POST /profiles, dispatching the request body to a
+// parser by Content-Type before validating and saving the result.
+func (s *Server) createProfile(w http.ResponseWriter, r *http.Request) {
+	profile, err := parseProfile(r, s.client.DataDir())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := validateProfile(profile); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.client.AddProfile(profile)
+	if err := s.client.SaveProfileToJSON(profile); err != nil {
+		http.Error(w, fmt.Sprintf("failed to save profile: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, profile)
+}
+
+// validateProfile checks the fields a submitted profile must carry.
+func validateProfile(p *models.Profile) error {
+	if p.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if p.Verdict == "" {
+		return fmt.Errorf("verdict is required")
+	}
+	return nil
+}
+
+// writeJSON encodes v as the JSON response body with the given status.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("failed to encode response: %v", err)
+	}
+}
+
+// parseProfile dispatches r's body to a parser by Content-Type, accepting
+// application/json, application/x-www-form-urlencoded, and
+// multipart/form-data (the latter also accepting an "image" file part,
+// saved via pkg/assets).
+func parseProfile(r *http.Request, dataDir string) (*models.Profile, error) {
+	contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		contentType = r.Header.Get("Content-Type")
+	}
+
+	switch {
+	case strings.HasPrefix(contentType, "application/json"):
+		var profile models.Profile
+		if err := json.NewDecoder(r.Body).Decode(&profile); err != nil {
+			return nil, fmt.Errorf("invalid JSON body: %w", err)
+		}
+		return &profile, nil
+
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		if err := r.ParseForm(); err != nil {
+			return nil, fmt.Errorf("invalid form body: %w", err)
+		}
+		return profileFromValues(r.Form), nil
+
+	case strings.HasPrefix(contentType, "multipart/form-data"):
+		return parseMultipartProfile(r, dataDir)
+
+	default:
+		return nil, fmt.Errorf("unsupported content type: %s", contentType)
+	}
+}
+
+// multipartMaxMemory bounds how much of a multipart body ParseMultipartForm
+// buffers in memory before spilling to temp files.
+const multipartMaxMemory = 10 << 20 // 10MB
+
+// parseMultipartProfile parses a multipart/form-data body into a Profile,
+// saving an "image" file part (if present) via pkg/assets.
+func parseMultipartProfile(r *http.Request, dataDir string) (*models.Profile, error) {
+	if err := r.ParseMultipartForm(multipartMaxMemory); err != nil {
+		return nil, fmt.Errorf("invalid multipart body: %w", err)
+	}
+
+	profile := profileFromValues(r.MultipartForm.Value)
+
+	if files := r.MultipartForm.File["image"]; len(files) > 0 {
+		file, err := files[0].Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open uploaded image: %w", err)
+		}
+		defer file.Close()
+
+		result, err := assets.Save(dataDir, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save uploaded image: %w", err)
+		}
+		profile.ImageLocal = result.LocalPath
+		profile.ImageMIME = result.MIME
+		profile.ImageSHA256 = result.SHA256
+		profile.Thumbnails = result.Thumbnails
+	}
+
+	return profile, nil
+}
+
+// profileFromValues builds a Profile from www-form/multipart field values.
+// Pros/Cons accept either a single newline-separated field or a repeated
+// "pros[]"/"cons[]" field.
+func profileFromValues(values map[string][]string) *models.Profile {
+	get := func(key string) string {
+		if v, ok := values[key]; ok && len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+	getList := func(key string) []string {
+		if v, ok := values[key+"[]"]; ok {
+			return v
+		}
+		return splitNonEmpty(get(key))
+	}
+
+	return &models.Profile{
+		Name:        get("name"),
+		URL:         get("url"),
+		Verdict:     get("verdict"),
+		Description: get("description"),
+		Category:    get("category"),
+		ImageURL:    get("image_url"),
+		Pros:        getList("pros"),
+		Cons:        getList("cons"),
+	}
+}
+
+// splitNonEmpty splits s on newlines, trims whitespace from each line, and
+// drops empty ones.
+func splitNonEmpty(s string) []string {
+	var out []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			out = append(out, line)
+		}
+	}
+	return out
+}
+
+// etagRecorder buffers a handler's response so withETag can hash the body
+// before committing headers.
+type etagRecorder struct {
+	header http.Header
+	body   bytes.Buffer
+	status int
+}
+
+func newETagRecorder() *etagRecorder {
+	return &etagRecorder{header: make(http.Header), status: http.StatusOK}
+}
+
+func (rec *etagRecorder) Header() http.Header         { return rec.header }
+func (rec *etagRecorder) Write(b []byte) (int, error) { return rec.body.Write(b) }
+func (rec *etagRecorder) WriteHeader(status int)      { rec.status = status }
+
+// withETag runs next against a buffering recorder, then serves the result
+// with an ETag derived from the body's SHA-256, replying 304 Not Modified
+// if the request's If-None-Match already matches.
+func withETag(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rec := newETagRecorder()
+		next(rec, r)
+
+		for k, v := range rec.header {
+			w.Header()[k] = v
+		}
+
+		if rec.status != http.StatusOK {
+			w.WriteHeader(rec.status)
+			w.Write(rec.body.Bytes())
+			return
+		}
+
+		sum := sha256.Sum256(rec.body.Bytes())
+		etag := `"` + hex.EncodeToString(sum[:]) + `"`
+		w.Header().Set("ETag", etag)
+
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.WriteHeader(rec.status)
+		w.Write(rec.body.Bytes())
+	}
+}
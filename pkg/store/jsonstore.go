@@ -0,0 +1,164 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// JSONStore persists one JSON file per profile under a directory, matching
+// the scraper's original on-disk layout.
+type JSONStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewJSONStore returns a JSONStore rooted at dir, creating it if necessary.
+func NewJSONStore(dir string) (*JSONStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	}
+	return &JSONStore{dir: dir}, nil
+}
+
+func (s *JSONStore) pathFor(profile *models.Profile) string {
+	safeName := url.PathEscape(profile.Name)
+	if safeName == "" {
+		safeName = "profile-" + time.Now().Format("20060102-150405")
+	}
+	return filepath.Join(s.dir, safeName+".json")
+}
+
+// Put writes profile to its JSON file.
+func (s *JSONStore) Put(profile *models.Profile) error {
+	if profile == nil || profile.Name == "" {
+		return fmt.Errorf("cannot save nil or unnamed profile")
+	}
+
+	data, err := json.MarshalIndent(profile, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal profile: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.WriteFile(s.pathFor(profile), data, 0644); err != nil {
+		return fmt.Errorf("failed to write profile JSON: %w", err)
+	}
+	return nil
+}
+
+func (s *JSONStore) all() ([]*models.Profile, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data directory: %w", err)
+	}
+
+	var profiles []*models.Profile
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", entry.Name(), err)
+		}
+
+		var profile models.Profile
+		if err := json.Unmarshal(data, &profile); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", entry.Name(), err)
+		}
+		profiles = append(profiles, &profile)
+	}
+	return profiles, nil
+}
+
+// Get retrieves a profile by name.
+func (s *JSONStore) Get(name string) (*models.Profile, error) {
+	profiles, err := s.all()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range profiles {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("profile not found: %s", name)
+}
+
+// List returns profiles matching filter.
+func (s *JSONStore) List(filter Filter) ([]*models.Profile, error) {
+	profiles, err := s.all()
+	if err != nil {
+		return nil, err
+	}
+	return filterProfiles(profiles, filter), nil
+}
+
+// Since returns profiles updated at or after t.
+func (s *JSONStore) Since(t time.Time) ([]*models.Profile, error) {
+	profiles, err := s.all()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*models.Profile
+	for _, p := range profiles {
+		updated, err := time.Parse(time.RFC3339, p.UpdatedAt)
+		if err == nil && !updated.Before(t) {
+			results = append(results, p)
+		}
+	}
+	return results, nil
+}
+
+// Search does a substring search over name/description.
+func (s *JSONStore) Search(query string) ([]*models.Profile, error) {
+	profiles, err := s.all()
+	if err != nil {
+		return nil, err
+	}
+
+	queryLower := strings.ToLower(query)
+	var results []*models.Profile
+	for _, p := range profiles {
+		if strings.Contains(strings.ToLower(p.Name), queryLower) ||
+			strings.Contains(strings.ToLower(p.Description), queryLower) {
+			results = append(results, p)
+		}
+	}
+	return results, nil
+}
+
+// Close is a no-op for JSONStore.
+func (s *JSONStore) Close() error {
+	return nil
+}
+
+func filterProfiles(profiles []*models.Profile, filter Filter) []*models.Profile {
+	if filter.Verdict == "" && filter.Category == "" {
+		return profiles
+	}
+
+	var results []*models.Profile
+	for _, p := range profiles {
+		if filter.Verdict != "" && !strings.EqualFold(p.Verdict, filter.Verdict) {
+			continue
+		}
+		if filter.Category != "" && !strings.EqualFold(p.Category, filter.Category) {
+			continue
+		}
+		results = append(results, p)
+	}
+	return results
+}
@@ -0,0 +1,172 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// PostgresStore persists profiles in Postgres, for operators who want a
+// shared, queryable backend instead of a local SQLite file.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore opens a Postgres-backed Store using connStr (a standard
+// "postgres://" DSN) and ensures its schema exists.
+func NewPostgresStore(connStr string) (*PostgresStore, error) {
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open postgres store: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping postgres store: %w", err)
+	}
+
+	s := &PostgresStore{db: db}
+	if err := s.initSchema(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *PostgresStore) initSchema() error {
+	schema := `
+	CREATE TABLE IF NOT EXISTS profiles (
+		name TEXT PRIMARY KEY,
+		url TEXT NOT NULL,
+		verdict TEXT,
+		description TEXT,
+		pros TEXT,
+		cons TEXT,
+		score DOUBLE PRECISION,
+		category TEXT,
+		image_url TEXT,
+		created_at TEXT,
+		updated_at TEXT
+	);
+	CREATE INDEX IF NOT EXISTS idx_profiles_verdict ON profiles (verdict);
+	CREATE INDEX IF NOT EXISTS idx_profiles_category ON profiles (category);
+	CREATE INDEX IF NOT EXISTS idx_profiles_updated_at ON profiles (updated_at);
+	`
+	if _, err := s.db.Exec(schema); err != nil {
+		return fmt.Errorf("failed to create postgres store schema: %w", err)
+	}
+	return nil
+}
+
+// Put inserts or updates profile.
+func (s *PostgresStore) Put(profile *models.Profile) error {
+	_, err := s.db.Exec(`
+		INSERT INTO profiles (name, url, verdict, description, pros, cons, score, category, image_url, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (name) DO UPDATE SET
+			url = excluded.url,
+			verdict = excluded.verdict,
+			description = excluded.description,
+			pros = excluded.pros,
+			cons = excluded.cons,
+			score = excluded.score,
+			category = excluded.category,
+			image_url = excluded.image_url,
+			updated_at = excluded.updated_at
+	`, profile.Name, profile.URL, profile.Verdict, profile.Description,
+		strings.Join(profile.Pros, "\n"), strings.Join(profile.Cons, "\n"),
+		profile.Score, profile.Category, profile.ImageURL, profile.CreatedAt, profile.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert profile: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves a profile by name.
+func (s *PostgresStore) Get(name string) (*models.Profile, error) {
+	row := s.db.QueryRow(pgBaseSelect+" WHERE name = $1", name)
+	profile, pros, cons := &models.Profile{}, "", ""
+	err := row.Scan(&profile.Name, &profile.URL, &profile.Verdict, &profile.Description,
+		&pros, &cons, &profile.Score, &profile.Category, &profile.ImageURL,
+		&profile.CreatedAt, &profile.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("profile not found: %s", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan profile: %w", err)
+	}
+	profile.Pros, profile.Cons = splitNonEmpty(pros), splitNonEmpty(cons)
+	return profile, nil
+}
+
+// List returns profiles matching filter.
+func (s *PostgresStore) List(filter Filter) ([]*models.Profile, error) {
+	query := pgBaseSelect
+	var args []interface{}
+	var clauses []string
+
+	if filter.Verdict != "" {
+		args = append(args, filter.Verdict)
+		clauses = append(clauses, fmt.Sprintf("verdict = $%d", len(args)))
+	}
+	if filter.Category != "" {
+		args = append(args, filter.Category)
+		clauses = append(clauses, fmt.Sprintf("category = $%d", len(args)))
+	}
+	if len(clauses) > 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+	defer rows.Close()
+	return s.scanAll(rows)
+}
+
+// Since returns profiles updated at or after t.
+func (s *PostgresStore) Since(t time.Time) ([]*models.Profile, error) {
+	rows, err := s.db.Query(pgBaseSelect+" WHERE updated_at >= $1", t.Format(time.RFC3339))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query profiles since %s: %w", t, err)
+	}
+	defer rows.Close()
+	return s.scanAll(rows)
+}
+
+// Search does a substring search over name/description (Postgres full-text
+// search via tsvector is a natural follow-up once this backend sees real use).
+func (s *PostgresStore) Search(query string) ([]*models.Profile, error) {
+	pattern := "%" + query + "%"
+	rows, err := s.db.Query(pgBaseSelect+" WHERE name ILIKE $1 OR description ILIKE $1", pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search profiles: %w", err)
+	}
+	defer rows.Close()
+	return s.scanAll(rows)
+}
+
+// Close closes the underlying database connection.
+func (s *PostgresStore) Close() error {
+	return s.db.Close()
+}
+
+const pgBaseSelect = `SELECT name, url, verdict, description, pros, cons, score, category, image_url, created_at, updated_at FROM profiles`
+
+func (s *PostgresStore) scanAll(rows *sql.Rows) ([]*models.Profile, error) {
+	var profiles []*models.Profile
+	for rows.Next() {
+		profile, pros, cons := &models.Profile{}, "", ""
+		if err := rows.Scan(&profile.Name, &profile.URL, &profile.Verdict, &profile.Description,
+			&pros, &cons, &profile.Score, &profile.Category, &profile.ImageURL,
+			&profile.CreatedAt, &profile.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan profile row: %w", err)
+		}
+		profile.Pros, profile.Cons = splitNonEmpty(pros), splitNonEmpty(cons)
+		profiles = append(profiles, profile)
+	}
+	return profiles, rows.Err()
+}
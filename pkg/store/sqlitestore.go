@@ -0,0 +1,213 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// SQLiteStore persists profiles in a SQLite database with an FTS5 virtual
+// table over description/pros/cons for full-text search, avoiding the
+// one-file-per-profile scaling problems of JSONStore.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore opens (creating if necessary) a SQLite-backed Store at path.
+func NewSQLiteStore(path string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite store: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping sqlite store: %w", err)
+	}
+
+	s := &SQLiteStore{db: db}
+	if err := s.initSchema(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *SQLiteStore) initSchema() error {
+	schema := `
+	CREATE TABLE IF NOT EXISTS profiles (
+		name TEXT PRIMARY KEY,
+		url TEXT NOT NULL,
+		verdict TEXT,
+		description TEXT,
+		pros TEXT,
+		cons TEXT,
+		score REAL,
+		category TEXT,
+		image_url TEXT,
+		created_at TEXT,
+		updated_at TEXT
+	);
+
+	CREATE VIRTUAL TABLE IF NOT EXISTS profiles_fts USING fts5(
+		name, description, pros, cons
+	);
+	`
+	_, err := s.db.Exec(schema)
+	if err != nil {
+		return fmt.Errorf("failed to create sqlite store schema: %w", err)
+	}
+	return nil
+}
+
+// Put inserts or updates profile, keeping profiles_fts in sync.
+func (s *SQLiteStore) Put(profile *models.Profile) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	pros := strings.Join(profile.Pros, "\n")
+	cons := strings.Join(profile.Cons, "\n")
+
+	_, err = tx.Exec(`
+		INSERT INTO profiles (name, url, verdict, description, pros, cons, score, category, image_url, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET
+			url = excluded.url,
+			verdict = excluded.verdict,
+			description = excluded.description,
+			pros = excluded.pros,
+			cons = excluded.cons,
+			score = excluded.score,
+			category = excluded.category,
+			image_url = excluded.image_url,
+			updated_at = excluded.updated_at
+	`, profile.Name, profile.URL, profile.Verdict, profile.Description, pros, cons,
+		profile.Score, profile.Category, profile.ImageURL, profile.CreatedAt, profile.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert profile: %w", err)
+	}
+
+	_, err = tx.Exec(`DELETE FROM profiles_fts WHERE name = ?`, profile.Name)
+	if err != nil {
+		return fmt.Errorf("failed to clear fts row: %w", err)
+	}
+	_, err = tx.Exec(`INSERT INTO profiles_fts (name, description, pros, cons) VALUES (?, ?, ?, ?)`,
+		profile.Name, profile.Description, pros, cons)
+	if err != nil {
+		return fmt.Errorf("failed to index profile for search: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Get retrieves a profile by name.
+func (s *SQLiteStore) Get(name string) (*models.Profile, error) {
+	profile, _, err := s.scanOne(s.db.QueryRow(baseSelect+" WHERE name = ?", name))
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// List returns profiles matching filter.
+func (s *SQLiteStore) List(filter Filter) ([]*models.Profile, error) {
+	query := baseSelect
+	var args []interface{}
+	var clauses []string
+
+	if filter.Verdict != "" {
+		clauses = append(clauses, "verdict = ?")
+		args = append(args, filter.Verdict)
+	}
+	if filter.Category != "" {
+		clauses = append(clauses, "category = ?")
+		args = append(args, filter.Category)
+	}
+	if len(clauses) > 0 {
+		query += " WHERE " + strings.Join(clauses, " AND ")
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+	defer rows.Close()
+	return s.scanAll(rows)
+}
+
+// Since returns profiles updated at or after t.
+func (s *SQLiteStore) Since(t time.Time) ([]*models.Profile, error) {
+	rows, err := s.db.Query(baseSelect+" WHERE updated_at >= ?", t.Format(time.RFC3339))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query profiles since %s: %w", t, err)
+	}
+	defer rows.Close()
+	return s.scanAll(rows)
+}
+
+// Search runs query against the FTS5 index and returns matching profiles.
+func (s *SQLiteStore) Search(query string) ([]*models.Profile, error) {
+	rows, err := s.db.Query(`
+		SELECT p.name, p.url, p.verdict, p.description, p.pros, p.cons, p.score, p.category, p.image_url, p.created_at, p.updated_at
+		FROM profiles_fts f
+		JOIN profiles p ON p.name = f.name
+		WHERE profiles_fts MATCH ?
+		ORDER BY rank
+	`, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run fts search %q: %w", query, err)
+	}
+	defer rows.Close()
+	return s.scanAll(rows)
+}
+
+// Close closes the underlying database connection.
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
+
+const baseSelect = `SELECT name, url, verdict, description, pros, cons, score, category, image_url, created_at, updated_at FROM profiles`
+
+func (s *SQLiteStore) scanAll(rows *sql.Rows) ([]*models.Profile, error) {
+	var profiles []*models.Profile
+	for rows.Next() {
+		profile, pros, cons := &models.Profile{}, "", ""
+		if err := rows.Scan(&profile.Name, &profile.URL, &profile.Verdict, &profile.Description,
+			&pros, &cons, &profile.Score, &profile.Category, &profile.ImageURL,
+			&profile.CreatedAt, &profile.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan profile row: %w", err)
+		}
+		profile.Pros = splitNonEmpty(pros)
+		profile.Cons = splitNonEmpty(cons)
+		profiles = append(profiles, profile)
+	}
+	return profiles, rows.Err()
+}
+
+func (s *SQLiteStore) scanOne(row *sql.Row) (*models.Profile, bool, error) {
+	profile, pros, cons := &models.Profile{}, "", ""
+	err := row.Scan(&profile.Name, &profile.URL, &profile.Verdict, &profile.Description,
+		&pros, &cons, &profile.Score, &profile.Category, &profile.ImageURL,
+		&profile.CreatedAt, &profile.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, false, fmt.Errorf("profile not found: %s", profile.Name)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to scan profile: %w", err)
+	}
+	profile.Pros = splitNonEmpty(pros)
+	profile.Cons = splitNonEmpty(cons)
+	return profile, true, nil
+}
+
+func splitNonEmpty(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
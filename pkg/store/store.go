@@ -0,0 +1,41 @@
+// Package store abstracts profile persistence behind a common interface so
+// the scraper isn't tied to one-JSON-file-per-profile: callers can plug in a
+// SQLite store (with FTS5 full-text search) or a Postgres store for larger
+// datasets, while the JSON directory layout remains available for local use.
+package store
+
+import (
+	"time"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+)
+
+// Filter narrows List to profiles matching the given (optional) criteria.
+// Zero-value fields are ignored.
+type Filter struct {
+	Verdict  string
+	Category string
+}
+
+// Store persists and queries profiles.
+type Store interface {
+	// Put inserts or updates profile.
+	Put(profile *models.Profile) error
+
+	// Get retrieves a profile by name.
+	Get(name string) (*models.Profile, error)
+
+	// List returns profiles matching filter (the zero Filter matches all).
+	List(filter Filter) ([]*models.Profile, error)
+
+	// Since returns profiles whose UpdatedAt is at or after t.
+	Since(t time.Time) ([]*models.Profile, error)
+
+	// Search does a free-text search. SQLiteStore searches
+	// name/description/pros/cons; JSONStore and PostgresStore search
+	// name/description only.
+	Search(query string) ([]*models.Profile, error)
+
+	// Close releases any resources held by the store.
+	Close() error
+}
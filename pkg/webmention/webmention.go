@@ -0,0 +1,136 @@
+// Package webmention parses an incoming Webmention's source page into a
+// models.Webmention by finding its microformats2 h-entry and classifying
+// it as a like/repost/reply/mention of a target URL, per the Webmention
+// and microformats2 specs.
+package webmention
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/marcusziade/jewornotjew/pkg/models"
+	"willnorris.com/go/microformats"
+)
+
+// ParseEntry reads a source page's HTML from body, parses its
+// microformats2 markup, and builds a models.Webmention describing how the
+// first h-entry it finds references targetURL. The Type is "like",
+// "repost", or "reply" if the entry's u-like-of/u-repost-of/u-in-reply-to
+// property names targetURL, or "mention" otherwise.
+func ParseEntry(body io.Reader, sourceURL string, base *url.URL, targetURL string) (*models.Webmention, error) {
+	data := microformats.Parse(body, base)
+
+	entry := findEntry(data.Items)
+	if entry == nil {
+		return &models.Webmention{
+			SourceURL: sourceURL,
+			Type:      "mention",
+			CreatedAt: time.Now().UTC().Format(time.RFC3339),
+		}, nil
+	}
+
+	wm := &models.Webmention{
+		SourceURL:   sourceURL,
+		Type:        entryType(entry, targetURL),
+		Content:     propertyText(entry, "content", "summary"),
+		PublishedAt: propertyText(entry, "published"),
+		CreatedAt:   time.Now().UTC().Format(time.RFC3339),
+	}
+
+	if author := authorOf(entry); author != nil {
+		wm.AuthorName = propertyText(author, "name")
+		wm.AuthorPhoto = propertyText(author, "photo")
+	}
+
+	return wm, nil
+}
+
+// findEntry returns the first h-entry among items, recursing into
+// children (h-entry is commonly nested inside an h-feed).
+func findEntry(items []*microformats.Microformat) *microformats.Microformat {
+	for _, item := range items {
+		if hasType(item, "h-entry") {
+			return item
+		}
+		if found := findEntry(item.Children); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func hasType(m *microformats.Microformat, typ string) bool {
+	for _, t := range m.Type {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// entryType classifies entry relative to targetURL using the
+// microformats2 response-type properties, falling back to "mention" when
+// none of them name targetURL.
+func entryType(entry *microformats.Microformat, targetURL string) string {
+	for _, rel := range []struct {
+		property, name string
+	}{
+		{"like-of", "like"},
+		{"repost-of", "repost"},
+		{"in-reply-to", "reply"},
+	} {
+		for _, v := range propertyValues(entry, rel.property) {
+			if urlsMatch(fmt.Sprint(v), targetURL) {
+				return rel.name
+			}
+		}
+	}
+	return "mention"
+}
+
+func urlsMatch(a, b string) bool {
+	return strings.TrimRight(a, "/") == strings.TrimRight(b, "/")
+}
+
+// authorOf returns the h-entry's author as a nested microformat, if its
+// "author" property is a full h-card rather than a bare string.
+func authorOf(entry *microformats.Microformat) *microformats.Microformat {
+	for _, v := range propertyValues(entry, "author") {
+		if author, ok := v.(*microformats.Microformat); ok {
+			return author
+		}
+	}
+	return nil
+}
+
+// propertyValues returns every raw value of property on m.
+func propertyValues(m *microformats.Microformat, property string) []interface{} {
+	if m.Properties == nil {
+		return nil
+	}
+	return m.Properties[property]
+}
+
+// propertyText returns the first value of the first present property in
+// names, rendered as plain text (a mf2 "value", not the embedded-markup
+// "html" some properties also carry).
+func propertyText(m *microformats.Microformat, names ...string) string {
+	for _, name := range names {
+		values := propertyValues(m, name)
+		if len(values) == 0 {
+			continue
+		}
+		switch v := values[0].(type) {
+		case string:
+			return v
+		case map[string]interface{}:
+			if value, ok := v["value"].(string); ok {
+				return value
+			}
+		}
+	}
+	return ""
+}